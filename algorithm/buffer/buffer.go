@@ -1,9 +1,12 @@
 package buffer
 
 import (
+	"math"
+
 	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/overlay"
 )
 
 // ComputerBuffer describes a geographic Element buffer
@@ -17,12 +20,26 @@ type ComputerBuffer struct {
 // Each offset curve has an attached  indicating
 // its left and right location.
 func Buffer(geom matrix.Steric, distance float64, quadrantSegments int) matrix.Steric {
+	param := DefaultCurveParameters()
+	param.QuadrantSegments = quadrantSegments
+	return BufferWithParams(geom, distance, param)
+}
+
+// BufferWithParams Computes the buffer of the geometry with the parameters of the end caps, the joins,
+// the mitre limit and single sided buffers.
+// A single sided buffer of a line is on the left of the line for a positive distance and on the right for a negative distance.
+func BufferWithParams(geom matrix.Steric, distance float64, param *CurveParameters) matrix.Steric {
+	if param == nil || param.IsEmpty() {
+		param = DefaultCurveParameters()
+	}
+	if coll, ok := geom.(matrix.Collection); ok {
+		return bufferCollection(coll, distance, param)
+	}
 	eb := ComputerBuffer{}
-	eb.param = DefaultCurveParameters()
-	eb.param.QuadrantSegments = quadrantSegments
+	eb.param = param
 	eb.distance = distance
 	eb.CurveBuilder = &CurveBuilder{
-		Curve: CurveWithParameters(eb.param, eb.distance),
+		Curve: CurveWithParameters(eb.param, math.Abs(eb.distance)),
 	}
 
 	eb.Add(geom)
@@ -30,11 +47,32 @@ func Buffer(geom matrix.Steric, distance float64, quadrantSegments int) matrix.S
 	if len(bufferSeg) <= 0 {
 		return nil
 	}
-	poly := matrix.PolygonMatrix{}
-	for _, v := range bufferSeg {
-		poly = append(poly, v.Line)
+	if len(bufferSeg) > 1 || !isSimpleCurves(bufferSeg) {
+		return buildBuffer(bufferSeg)
 	}
-	return poly
+	return matrix.PolygonMatrix{bufferSeg[0].Line}
+}
+
+// bufferCollection returns the union of the buffers of the elements of the collection.
+func bufferCollection(coll matrix.Collection, distance float64, param *CurveParameters) matrix.Steric {
+	polys := []matrix.PolygonMatrix{}
+	for _, v := range coll {
+		if v.IsEmpty() {
+			continue
+		}
+		switch b := BufferWithParams(v, distance, param).(type) {
+		case matrix.PolygonMatrix:
+			polys = append(polys, b)
+		case matrix.Collection:
+			for _, p := range b {
+				polys = append(polys, p.(matrix.PolygonMatrix))
+			}
+		}
+	}
+	if len(polys) == 0 {
+		return nil
+	}
+	return overlay.CascadedUnion(polys)
 }
 
 // Add Add a geometry to the graph.
@@ -56,11 +94,8 @@ func (eb *ComputerBuffer) Add(geom matrix.Steric) {
 	case matrix.PolygonMatrix:
 		eb.addPolygon(st)
 	case matrix.Collection:
-		// TODO add support collection
-		for i, v := range st {
-			if i == 0 {
-				eb.Add(v)
-			}
+		for _, v := range st {
+			eb.Add(v)
 		}
 	}
 }
@@ -118,23 +153,29 @@ func (eb *ComputerBuffer) addPolygon(p matrix.PolygonMatrix) {
 	if eb.distance <= 0.0 && len(shell) < 3 {
 		return
 	}
-	eb.addRingSide(
+	// a shell eroded completely leaves nothing of the polygon
+	if eb.distance < 0.0 && isErodedCompletely(shell, eb.distance) {
+		return
+	}
+	// an inverted offset curve of the shell is a shell eroded completely
+	if curve := eb.addRingSide(
 		shell,
 		offsetDistance,
 		offsetSide,
 		calc.EXTERIOR,
-		calc.INTERIOR)
-
-	if offsetSide == calc.LEFT {
-		offsetSide = calc.RIGHT
-	}
-	if offsetSide == calc.RIGHT {
-		offsetSide = calc.LEFT
+		calc.INTERIOR); eb.distance < 0.0 && curve == nil {
+		return
 	}
 
+	offsetSide = oppositeSide(offsetSide)
+
 	for i := 1; i < len(p); i++ {
 
 		hole := p[i]
+		// a hole filled completely by a positive buffer is dropped
+		if eb.distance > 0.0 && isErodedCompletely(hole, -eb.distance) {
+			continue
+		}
 
 		// Holes are topologically labelled opposite to the shell, since
 		// the interior of the polygon lies on their opposite side
@@ -163,10 +204,11 @@ func (eb *ComputerBuffer) addRingBothSides(ring matrix.LineMatrix, distance floa
 // (If the ring is in the opposite orientation,
 // this is detected and
 // the left and right locations are interchanged and the side is flipped.)
-func (eb *ComputerBuffer) addRingSide(ring matrix.LineMatrix, offsetDistance float64, side, cwLeftLoc, cwRightLoc int) {
+// It returns the offset curve, nil if the curve is inverted.
+func (eb *ComputerBuffer) addRingSide(ring matrix.LineMatrix, offsetDistance float64, side, cwLeftLoc, cwRightLoc int) matrix.LineMatrix {
 	// don't bother adding ring if it is "flat" and will disappear in the output
 	if offsetDistance == 0.0 && len(ring) < calc.MinRingSize {
-		return
+		return nil
 	}
 
 	leftLoc := cwLeftLoc
@@ -175,14 +217,42 @@ func (eb *ComputerBuffer) addRingSide(ring matrix.LineMatrix, offsetDistance flo
 	if len(ring) >= calc.MinRingSize && isCCW {
 		leftLoc = cwRightLoc
 		rightLoc = cwLeftLoc
-		if side == calc.LEFT {
-			side = calc.RIGHT
-		}
-		if side == calc.RIGHT {
-			side = calc.LEFT
-		}
+		side = oppositeSide(side)
+	}
+	return eb.RingCurve(matrix.LineMatrix(ring), offsetDistance, side, leftLoc, rightLoc)
+}
+
+// oppositeSide returns the opposite side of the side, left or right.
+func oppositeSide(side int) int {
+	if side == calc.LEFT {
+		return calc.RIGHT
+	}
+	return calc.LEFT
+}
 
+// isErodedCompletely Tests whether a ring buffered inwards by the negative distance is empty,
+// which is the case if the ring is smaller than the distance across,
+// or for a triangle if its inscribed circle is smaller than the distance.
+// Other rings eroded completely are detected by their inverted offset curves.
+func isErodedCompletely(ring matrix.LineMatrix, distance float64) bool {
+	if len(ring) < calc.MinRingSize {
+		return distance < 0.0
+	}
+	if len(ring) == calc.MinRingSize+1 {
+		return distance < 0.0 && inRadius(ring) < math.Abs(distance)
 	}
-	eb.RingCurve(matrix.LineMatrix(ring), offsetDistance, side, leftLoc, rightLoc)
+	minX, minY, maxX, maxY := ring[0][0], ring[0][1], ring[0][0], ring[0][1]
+	for _, v := range ring {
+		minX, maxX = math.Min(minX, v[0]), math.Max(maxX, v[0])
+		minY, maxY = math.Min(minY, v[1]), math.Max(maxY, v[1])
+	}
+	return distance < 0.0 && 2*math.Abs(distance) > math.Min(maxX-minX, maxY-minY)
+}
 
+// inRadius returns the radius of the inscribed circle of the triangle.
+func inRadius(triangle matrix.LineMatrix) float64 {
+	a, b, c := triangle[0], triangle[1], triangle[2]
+	area2 := math.Abs((b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0]))
+	perimeter := math.Hypot(b[0]-a[0], b[1]-a[1]) + math.Hypot(c[0]-b[0], c[1]-b[1]) + math.Hypot(a[0]-c[0], a[1]-c[1])
+	return area2 / perimeter
 }
@@ -0,0 +1,91 @@
+package buffer
+
+import (
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// isSimpleCurves returns true if the offset curves neither intersect themselves nor each other.
+func isSimpleCurves(curves []Curve) bool {
+	lines, count := make([]matrix.LineMatrix, len(curves)), 0
+	for i, v := range curves {
+		lines[i] = v.Line
+		count += len(v.Line) - 1
+	}
+	segments := graph.NodeLines(lines)
+	if len(segments) != count {
+		return false
+	}
+	g := graph.NewPlanarGraph(segments)
+	degree := make([]int, len(g.Nodes))
+	for _, e := range g.Edges {
+		degree[e.From]++
+	}
+	for _, v := range degree {
+		if v != 2 {
+			return false
+		}
+	}
+	return true
+}
+
+// buildBuffer returns the polygons of the area of positive depth of the offset curves,
+// the depth of a point is the sum of the winding numbers of the curves around it, signed by the interior side of the curves.
+// Loops of inside turns and overlaps of the curves are resolved this way.
+func buildBuffer(curves []Curve) matrix.Steric {
+	lines := make([]matrix.LineMatrix, len(curves))
+	for i, v := range curves {
+		lines[i] = v.Line
+	}
+	g := graph.NewPlanarGraph(graph.NodeLines(lines))
+	faces := g.Faces()
+	inside := make([]bool, len(faces))
+	for i, f := range faces {
+		inside[i] = f.IsBounded() && depth(graph.InteriorPoint(f.Ring, f.Holes), curves) > 0
+	}
+	polys := g.Polygons(func(e int) bool {
+		return inside[g.Edges[e].Face] && !inside[g.Edges[graph.Twin(e)].Face]
+	})
+	switch len(polys) {
+	case 0:
+		return nil
+	case 1:
+		return polys[0]
+	}
+	coll := matrix.Collection{}
+	for _, v := range polys {
+		coll = append(coll, v)
+	}
+	return coll
+}
+
+// depth returns the depth of the point in the offset curves.
+func depth(pt matrix.Matrix, curves []Curve) int {
+	d := 0
+	for _, v := range curves {
+		if v.leftLoc == calc.INTERIOR {
+			d += windingNumber(pt, v.Line)
+		} else {
+			d -= windingNumber(pt, v.Line)
+		}
+	}
+	return d
+}
+
+// windingNumber returns the number of times the ring winds counter clockwise around the point.
+func windingNumber(pt matrix.Matrix, ring matrix.LineMatrix) int {
+	wn := 0
+	for i := 0; i < len(ring)-1; i++ {
+		p, q := ring[i], ring[i+1]
+		side := graph.Orientation(p, q, pt)
+		if p[1] <= pt[1] {
+			if q[1] > pt[1] && side > 0 {
+				wn++
+			}
+		} else if q[1] <= pt[1] && side < 0 {
+			wn--
+		}
+	}
+	return wn
+}
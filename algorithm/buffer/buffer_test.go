@@ -3,6 +3,7 @@ package buffer
 import (
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
@@ -60,11 +61,57 @@ func TestBuffer(t *testing.T) {
 			geom:     matrix.Collection{matrix.Matrix{100, 100}, matrix.Matrix{200, 200}},
 			distance: 50,
 			quadsegs: 4,
-		}, want: matrix.PolygonMatrix{
-			{{150, 100}, {146.19397662556435, 80.86582838174553}, {135.3553390593274, 64.64466094067265}, {119.13417161825454, 53.80602337443568}, {100.00000000000009, 50}, {
-				80.86582838174562, 53.806023374435625}, {64.64466094067271, 64.64466094067254}, {53.80602337443571, 80.86582838174539}, {50, 99.99999999999984}, {
-				53.80602337443559, 119.13417161825431}, {64.64466094067248, 135.35533905932724}, {80.86582838174532, 146.19397662556426}, {99.99999999999977, 150}, {
-				119.13417161825426, 146.19397662556443}, {135.35533905932718, 135.35533905932758}, {146.19397662556423, 119.13417161825477}, {150, 100}},
+		}, want: matrix.Collection{
+			matrix.PolygonMatrix{{{146.19397662556435, 80.86582838174552}, {150, 100}, {146.19397662556432, 119.13417161825453}, {135.35533905932738, 135.35533905932738}, {
+				119.1341716182545, 146.19397662556435}, {99.99999999999999, 150}, {80.86582838174549, 146.19397662556432}, {64.64466094067262, 135.35533905932738}, {
+				53.80602337443566, 119.13417161825448}, {50, 100}, {53.80602337443566, 80.8658283817455}, {64.64466094067262, 64.64466094067262}, {
+				80.86582838174552, 53.80602337443566}, {100, 50}, {119.1341716182545, 53.80602337443566}, {135.35533905932738, 64.64466094067262}, {146.19397662556435, 80.86582838174552}}},
+			matrix.PolygonMatrix{{{246.19397662556435, 180.8658283817455}, {250, 200}, {246.19397662556432, 219.13417161825453}, {235.35533905932738, 235.35533905932738}, {
+				219.1341716182545, 246.19397662556435}, {200, 250}, {180.86582838174547, 246.19397662556432}, {164.64466094067262, 235.35533905932738}, {
+				153.80602337443565, 219.13417161825447}, {150, 200}, {153.80602337443565, 180.8658283817455}, {164.64466094067262, 164.64466094067262}, {
+				180.8658283817455, 153.80602337443565}, {200, 150}, {219.1341716182545, 153.80602337443565}, {235.35533905932738, 164.64466094067262}, {246.19397662556435, 180.8658283817455}}},
+		},
+		},
+
+		{name: "multi point buffer overlap", args: args{
+			geom:     matrix.Collection{matrix.Matrix{100, 100}, matrix.Matrix{150, 100}},
+			distance: 50,
+			quadsegs: 1,
+		}, want: matrix.PolygonMatrix{{{100, 50}, {125, 75}, {150, 50}, {200, 100}, {150, 150}, {125, 125}, {100, 150}, {50, 100}, {100, 50}}},
+		},
+
+		{name: "multi polygon buffer", args: args{
+			geom: matrix.Collection{
+				matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+				matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+			},
+			distance: 1,
+			quadsegs: 1,
+		}, want: matrix.PolygonMatrix{{{-1, 0}, {0, -1}, {10, -1}, {11, 0}, {11, 4}, {15, 4}, {16, 5}, {16, 15}, {15, 16}, {5, 16}, {4, 15}, {4, 11}, {0, 11}, {-1, 10}, {-1, 0}}},
+		},
+
+		{name: "negative poly buffer", args: args{
+			geom:     matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			distance: -2,
+			quadsegs: 2,
+		}, want: matrix.PolygonMatrix{{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}}},
+		},
+
+		{name: "negative poly buffer hole", args: args{
+			geom:     matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}}},
+			distance: -1,
+			quadsegs: 1,
+		}, want: matrix.PolygonMatrix{{{1, 1}, {9, 1}, {9, 9}, {1, 9}, {1, 1}}, {{4, 3}, {3, 4}, {3, 6}, {4, 7}, {6, 7}, {7, 6}, {7, 4}, {6, 3}, {4, 3}}},
+		},
+
+		{name: "negative poly buffer split", args: args{
+			geom: matrix.PolygonMatrix{{{0, 0}, {4, 0}, {4, 1.5}, {6, 1.5}, {6, 0}, {10, 0}, {10, 4}, {6, 4}, {6, 2.5},
+				{4, 2.5}, {4, 4}, {0, 4}, {0, 0}}},
+			distance: -1,
+			quadsegs: 1,
+		}, want: matrix.Collection{
+			matrix.PolygonMatrix{{{1, 1}, {3, 1}, {3, 1.5}, {3.5, 2}, {3, 2.5}, {3, 3}, {1, 3}, {1, 1}}},
+			matrix.PolygonMatrix{{{6.5, 2}, {7, 1.5}, {7, 1}, {9, 1}, {9, 3}, {7, 3}, {7, 2.5}, {6.5, 2}}},
 		},
 		},
 	}
@@ -75,4 +122,58 @@ func TestBuffer(t *testing.T) {
 			}
 		})
 	}
+	eroded := []args{
+		{geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, distance: -6, quadsegs: 4},
+		{geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}}}, distance: -6, quadsegs: 4},
+		{geom: matrix.LineMatrix{{0, 0}, {10, 0}}, distance: -6, quadsegs: 4},
+		{geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {0, 10}, {0, 0}}}, distance: -3, quadsegs: 8},
+		{geom: matrix.PolygonMatrix{{{0, 0}, {1, 0}, {11, 10}, {10, 10}, {0, 0}}}, distance: -1, quadsegs: 8},
+		{geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 2}, {2, 2}, {2, 10}, {0, 10}, {0, 0}}}, distance: -1.5, quadsegs: 8},
+	}
+	for _, v := range eroded {
+		if got := Buffer(v.geom, v.distance, v.quadsegs); got != nil {
+			t.Errorf("Buffer() eroded %v = %v, want nil", v.geom, got)
+		}
+	}
+}
+
+func TestBufferWithParams(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	type args struct {
+		geom     matrix.Steric
+		distance float64
+		param    *CurveParameters
+	}
+	tests := []struct {
+		name string
+		args args
+		want matrix.Steric
+	}{
+		{name: "flat mitre", args: args{line, 1,
+			&CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPFLAT, JoinStyle: calc.JOINMITRE, MitreLimit: 5}},
+			want: matrix.PolygonMatrix{{{9, 1}, {9, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 1}, {9, 1}}}},
+		{name: "square bevel", args: args{line, 1,
+			&CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPSQUARE, JoinStyle: calc.JOINBEVEL, MitreLimit: 5}},
+			want: matrix.PolygonMatrix{{{9, 1}, {9, 10}, {9, 11}, {11, 11}, {11, 0}, {10, -1}, {0, -1}, {-1, -1}, {-1, 1}, {9, 1}}}},
+		{name: "single sided left", args: args{line, 1,
+			&CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPFLAT, JoinStyle: calc.JOINMITRE, MitreLimit: 5, IsSingleSided: true}},
+			want: matrix.PolygonMatrix{{{10, 10}, {10, 0}, {0, 0}, {0, 1}, {9, 1}, {9, 10}, {10, 10}}}},
+		{name: "single sided right", args: args{line, -1,
+			&CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPFLAT, JoinStyle: calc.JOINMITRE, MitreLimit: 5, IsSingleSided: true}},
+			want: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {11, 10}, {11, -1}, {0, -1}, {0, 0}}}},
+		{name: "point square cap", args: args{matrix.Matrix{0, 0}, 1,
+			&CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPSQUARE, JoinStyle: calc.JOINROUND, MitreLimit: 5}},
+			want: matrix.PolygonMatrix{{{1, 1}, {1, -1}, {-1, -1}, {-1, 1}, {1, 1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BufferWithParams(tt.args.geom, tt.args.distance, tt.args.param); !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("BufferWithParams() = %v,\n want %v", got, tt.want)
+			}
+		})
+	}
+	pointFlat := &CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPFLAT, JoinStyle: calc.JOINROUND, MitreLimit: 5}
+	if got := BufferWithParams(matrix.Matrix{0, 0}, 1, pointFlat); got != nil {
+		t.Errorf("BufferWithParams() point flat cap = %v, want nil", got)
+	}
 }
@@ -30,6 +30,14 @@ func TestComputeCentroid(t *testing.T) {
 		},
 			matrix.Matrix{2.5, 2.5},
 		},
+
+		{"centroid area with hole", args{matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{1, 1}, {1, 5}, {5, 5}, {5, 1}, {1, 1}},
+		},
+		},
+			matrix.Matrix{452.0 / 84, 452.0 / 84},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
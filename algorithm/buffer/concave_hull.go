@@ -0,0 +1,259 @@
+package buffer
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/triangulate"
+)
+
+// ConcaveHull Computes the concave hull of the vertices of the geometry, with the target edge length given as a ratio
+// between the shortest and the longest edge of the Delaunay triangulation of the vertices.
+// A ratio of 1 gives the convex hull, a ratio of 0 the most concave hull. See ConcaveHullByLength.
+func ConcaveHull(geom matrix.Steric, lengthRatio float64, holesAllowed bool) matrix.Steric {
+	pts := extractMatrixes(geom)
+	t := triangulate.NewDelaunay(pts, 0)
+	if t.NumTriangles() == 0 {
+		return ConvexHullWithGeom(geom).ConvexHull()
+	}
+	minLen, maxLen := math.MaxFloat64, 0.0
+	for e := range t.Triangles {
+		l := edgeLength(t, e)
+		minLen, maxLen = math.Min(minLen, l), math.Max(maxLen, l)
+	}
+	lengthRatio = math.Max(0, math.Min(1, lengthRatio))
+	return newConcaveHull(t, holesAllowed).compute(minLen + lengthRatio*(maxLen-minLen))
+}
+
+// ConcaveHullByLength Computes the concave hull of the vertices of the geometry,
+// a polygon which contains all the vertices and has no edge longer than the max edge length,
+// unless it is needed to keep the hull a single polygon.
+// The hull is found by eroding the triangles of the Delaunay triangulation of the vertices from the border,
+// in the order of the length of their border edge, while the border edge is longer than the max edge length.
+// If holes are allowed, inner triangles with an edge longer than the max edge length are removed next,
+// if their vertices are not on the border, and the holes are eroded like the border.
+// The result is a single valid polygon, or the convex hull if the vertices are fewer than 3 or collinear.
+func ConcaveHullByLength(geom matrix.Steric, maxEdgeLength float64, holesAllowed bool) matrix.Steric {
+	pts := extractMatrixes(geom)
+	t := triangulate.NewDelaunay(pts, 0)
+	if t.NumTriangles() == 0 {
+		return ConvexHullWithGeom(geom).ConvexHull()
+	}
+	return newConcaveHull(t, holesAllowed).compute(maxEdgeLength)
+}
+
+// concaveHull the state of the erosion of the triangles.
+type concaveHull struct {
+	t            *triangulate.Triangulation
+	holesAllowed bool
+	// erodeHoles is set after the border is eroded, if holes are allowed.
+	erodeHoles bool
+	removed    []bool
+	// borderEdges is the number of border edges at each point.
+	borderEdges []int
+}
+
+func newConcaveHull(t *triangulate.Triangulation, holesAllowed bool) *concaveHull {
+	c := &concaveHull{
+		t:            t,
+		holesAllowed: holesAllowed,
+		removed:      make([]bool, t.NumTriangles()),
+		borderEdges:  make([]int, len(t.Points)),
+	}
+	for e := range t.Triangles {
+		if c.isBorder(e) {
+			c.borderEdges[t.Triangles[e]]++
+			c.borderEdges[t.Triangles[nextEdge(e)]]++
+		}
+	}
+	return c
+}
+
+// compute removes the triangles from the border, then the inner triangles if holes are allowed,
+// and returns the polygon of the triangles left.
+func (c *concaveHull) compute(maxEdgeLength float64) matrix.PolygonMatrix {
+	c.erode(maxEdgeLength)
+	if c.holesAllowed {
+		c.erodeHoles = true
+		c.erode(maxEdgeLength)
+	}
+	return c.polygon()
+}
+
+// erode removes the removable triangles with a key longer than the max edge length, the longest first.
+func (c *concaveHull) erode(maxEdgeLength float64) {
+	queue := &triangleQueue{}
+	for tri := range c.removed {
+		if key := c.key(tri); key > maxEdgeLength {
+			heap.Push(queue, triangleItem{tri, key})
+		}
+	}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(triangleItem)
+		if c.removed[item.tri] {
+			continue
+		}
+		key := c.key(item.tri)
+		if key != item.key {
+			if key > maxEdgeLength {
+				heap.Push(queue, triangleItem{item.tri, key})
+			}
+			continue
+		}
+		if !c.isRemovable(item.tri) {
+			continue
+		}
+		c.remove(item.tri)
+		for e := 3 * item.tri; e < 3*item.tri+3; e++ {
+			if h := c.t.Halfedges[e]; h != -1 && !c.removed[h/3] {
+				if key := c.key(h / 3); key > maxEdgeLength {
+					heap.Push(queue, triangleItem{h / 3, key})
+				}
+			}
+		}
+	}
+}
+
+// key returns the length of the longest border edge of the triangle,
+// or of its longest edge if holes are eroded and it has no border edge.
+func (c *concaveHull) key(tri int) float64 {
+	border, inner := -1.0, -1.0
+	for e := 3 * tri; e < 3*tri+3; e++ {
+		l := edgeLength(c.t, e)
+		if c.isBorder(e) {
+			border = math.Max(border, l)
+		} else {
+			inner = math.Max(inner, l)
+		}
+	}
+	if border >= 0 {
+		return border
+	}
+	if c.erodeHoles {
+		return inner
+	}
+	return -1
+}
+
+// isRemovable returns true if the triangles left after removing the triangle still form a single polygon
+// containing all the points, the triangle has one border edge and its opposite point is not on the border,
+// or, if holes are eroded, it has no border edge and none of its points is on the border.
+func (c *concaveHull) isRemovable(tri int) bool {
+	borders, apex := 0, -1
+	for e := 3 * tri; e < 3*tri+3; e++ {
+		if c.isBorder(e) {
+			borders++
+			apex = c.t.Triangles[prevEdge(e)]
+		}
+	}
+	switch borders {
+	case 0:
+		if !c.erodeHoles {
+			return false
+		}
+		for e := 3 * tri; e < 3*tri+3; e++ {
+			if c.borderEdges[c.t.Triangles[e]] > 0 {
+				return false
+			}
+		}
+		return true
+	case 1:
+		return c.borderEdges[apex] == 0
+	}
+	return false
+}
+
+// remove removes the triangle, its border edges are no longer border edges, the others become border edges.
+func (c *concaveHull) remove(tri int) {
+	for e := 3 * tri; e < 3*tri+3; e++ {
+		delta := 1
+		if c.isBorder(e) {
+			delta = -1
+		}
+		c.borderEdges[c.t.Triangles[e]] += delta
+		c.borderEdges[c.t.Triangles[nextEdge(e)]] += delta
+	}
+	c.removed[tri] = true
+}
+
+// isBorder returns true if the half-edge is on the hull or its opposite triangle is removed.
+func (c *concaveHull) isBorder(e int) bool {
+	h := c.t.Halfedges[e]
+	return h == -1 || c.removed[h/3]
+}
+
+// polygon traces the border edges of the triangles left into the shell and the holes of the polygon.
+func (c *concaveHull) polygon() matrix.PolygonMatrix {
+	// the triangles are clockwise, the border edges run clockwise around the triangles left
+	outgoing := map[int]int{}
+	for e := range c.t.Triangles {
+		if !c.removed[e/3] && c.isBorder(e) {
+			outgoing[c.t.Triangles[e]] = c.t.Triangles[nextEdge(e)]
+		}
+	}
+	visited := map[int]bool{}
+	rings := []matrix.LineMatrix{}
+	for start := range c.t.Points {
+		if _, ok := outgoing[start]; !ok || visited[start] {
+			continue
+		}
+		ring := matrix.LineMatrix{}
+		for v := start; !visited[v]; v = outgoing[v] {
+			visited[v] = true
+			ring = append(ring, c.t.Points[v])
+		}
+		ring = append(ring, c.t.Points[start])
+		rings = append(rings, graph.Reverse(ring))
+	}
+	// the shell is counter clockwise, the holes are clockwise
+	poly := matrix.PolygonMatrix{}
+	for _, ring := range rings {
+		if measure.AreaDirection(ring) < 0 {
+			poly = append(matrix.PolygonMatrix{ring}, poly...)
+		} else {
+			poly = append(poly, ring)
+		}
+	}
+	return poly
+}
+
+func edgeLength(t *triangulate.Triangulation, e int) float64 {
+	return math.Sqrt(graph.SquareDistance(t.Points[t.Triangles[e]], t.Points[t.Triangles[nextEdge(e)]]))
+}
+
+func nextEdge(e int) int {
+	if e%3 == 2 {
+		return e - 2
+	}
+	return e + 1
+}
+
+func prevEdge(e int) int {
+	if e%3 == 0 {
+		return e + 2
+	}
+	return e - 1
+}
+
+// triangleItem a triangle in the queue, with the length of its edge.
+type triangleItem struct {
+	tri int
+	key float64
+}
+
+// triangleQueue a priority queue of the triangles, the longest edge first.
+type triangleQueue []triangleItem
+
+func (q triangleQueue) Len() int            { return len(q) }
+func (q triangleQueue) Less(i, j int) bool  { return q[i].key > q[j].key }
+func (q triangleQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *triangleQueue) Push(x interface{}) { *q = append(*q, x.(triangleItem)) }
+func (q *triangleQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
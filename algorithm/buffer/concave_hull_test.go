@@ -0,0 +1,141 @@
+package buffer
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/relate"
+	"github.com/spatial-go/geoos/algorithm/triangulate"
+)
+
+func polygonArea(poly matrix.PolygonMatrix) float64 {
+	area := 0.0
+	for i, ring := range poly {
+		if i == 0 {
+			area += math.Abs(measure.AreaDirection(ring))
+		} else {
+			area -= math.Abs(measure.AreaDirection(ring))
+		}
+	}
+	return area
+}
+
+func TestConcaveHullByLength(t *testing.T) {
+	trapezoid := matrix.Collection{
+		matrix.Matrix{0, 0}, matrix.Matrix{10, 0}, matrix.Matrix{11, 8}, matrix.Matrix{-1, 8}, matrix.Matrix{5, 6},
+	}
+	annulus := matrix.LineMatrix{}
+	for i := 0; i < 40; i++ {
+		a := 2 * math.Pi * float64(i) / 40
+		annulus = append(annulus, []float64{10 * math.Cos(a), 10 * math.Sin(a)})
+	}
+	for i := 0; i < 12; i++ {
+		a := 2 * math.Pi * float64(i) / 12
+		annulus = append(annulus, []float64{3 * math.Cos(a), 3 * math.Sin(a)})
+	}
+	tests := []struct {
+		name          string
+		geom          matrix.Steric
+		maxEdgeLength float64
+		holesAllowed  bool
+		rings         int
+		area          float64
+	}{
+		{name: "notch", geom: trapezoid, maxEdgeLength: 11, rings: 1, area: 76},
+		{name: "convex", geom: trapezoid, maxEdgeLength: 20, rings: 1, area: 88},
+		{name: "annulus without holes", geom: annulus, maxEdgeLength: 4, rings: 1, area: 20 * 10 * 10 * math.Sin(math.Pi/20)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ConcaveHullByLength(tt.geom, tt.maxEdgeLength, tt.holesAllowed).(matrix.PolygonMatrix)
+			if !ok || len(got) != tt.rings {
+				t.Fatalf("ConcaveHullByLength() = %v, want %v rings", got, tt.rings)
+			}
+			if area := polygonArea(got); math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("ConcaveHullByLength() area = %v, want %v", area, tt.area)
+			}
+			if measure.AreaDirection(got[0]) >= 0 {
+				t.Errorf("ConcaveHullByLength() shell is not counter clockwise")
+			}
+		})
+	}
+
+	// the hole is inside the inner circle, the triangles along the inner circle with short edges are kept
+	withHoles := ConcaveHullByLength(annulus, 4, true).(matrix.PolygonMatrix)
+	outer, inner := 20*10*10*math.Sin(math.Pi/20), 6*3*3*math.Sin(math.Pi/6)
+	if area := polygonArea(withHoles); len(withHoles) != 2 || area < outer-inner || area >= outer {
+		t.Errorf("ConcaveHullByLength() with holes = %v rings, area %v", len(withHoles), area)
+	}
+
+	line := matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}
+	if got := ConcaveHullByLength(line, 0, false); !got.Equals(matrix.LineMatrix{{0, 0}, {2, 2}}) {
+		t.Errorf("ConcaveHullByLength() collinear = %v", got)
+	}
+}
+
+func TestConcaveHull(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	pts := matrix.Collection{}
+	for len(pts) < 500 {
+		x, y := r.Float64()*100, r.Float64()*100
+		if (x > 30 && y > 30 && y < 70) || (x > 10 && x < 20 && y > 10 && y < 20) {
+			continue
+		}
+		pts = append(pts, matrix.Matrix{x, y})
+	}
+	convex := 0.0
+	for _, v := range triangulate.DelaunayTriangles(pts, 0) {
+		convex += polygonArea(v)
+	}
+	for _, ratio := range []float64{1, 0.3, 0.1, 0.05, 0} {
+		withoutHoles := ConcaveHull(pts, ratio, false).(matrix.PolygonMatrix)
+		withHoles := ConcaveHull(pts, ratio, true).(matrix.PolygonMatrix)
+		if len(withoutHoles) != 1 {
+			t.Errorf("ConcaveHull(%v) has holes", ratio)
+		}
+		if ratio == 1 && math.Abs(polygonArea(withoutHoles)-convex) > 1e-9 {
+			t.Errorf("ConcaveHull(1) area = %v, want convex hull area %v", polygonArea(withoutHoles), convex)
+		}
+		if polygonArea(withHoles) > polygonArea(withoutHoles)+1e-9 {
+			t.Errorf("ConcaveHull(%v) with holes is larger than without", ratio)
+		}
+		for _, hull := range []matrix.PolygonMatrix{withoutHoles, withHoles} {
+			seen := map[[2]float64]bool{}
+			for _, ring := range hull {
+				for _, v := range ring[:len(ring)-1] {
+					if seen[[2]float64{v[0], v[1]}] {
+						t.Fatalf("ConcaveHull(%v) repeats the vertex %v", ratio, v)
+					}
+					seen[[2]float64{v[0], v[1]}] = true
+				}
+			}
+			for _, v := range pts {
+				p := v.(matrix.Matrix)
+				if !coveredBy(p, hull) {
+					t.Fatalf("ConcaveHull(%v) does not cover %v", ratio, p)
+				}
+			}
+		}
+	}
+}
+
+func coveredBy(p matrix.Matrix, poly matrix.PolygonMatrix) bool {
+	for _, ring := range poly {
+		if graph.OnLine(p, ring) {
+			return true
+		}
+	}
+	if !relate.InPolygon(p, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if relate.InPolygon(p, hole) {
+			return false
+		}
+	}
+	return true
+}
@@ -88,9 +88,17 @@ func (c *ConvexHull) reduce() []matrix.Matrix {
 	for _, v := range polyPts {
 		ls = append(ls, v)
 	}
+	// keep the points defining the ring and the points outside of it
 	reducedSet := list.New()
+	seen := map[[2]float64]bool{}
+	for _, v := range polyPts {
+		if !seen[[2]float64{v[0], v[1]}] {
+			seen[[2]float64{v[0], v[1]}] = true
+			reducedSet.PushBack(v)
+		}
+	}
 	for _, v := range c.inputPts {
-		if !relate.InLineMatrix(v, ls) {
+		if !seen[[2]float64{v[0], v[1]}] && !relate.InPolygon(v, ls) {
 			reducedSet.PushBack(v)
 		}
 	}
@@ -106,7 +114,7 @@ func (c *ConvexHull) reduce() []matrix.Matrix {
 func (c *ConvexHull) padArray3(pts []matrix.Matrix) []matrix.Matrix {
 	pad := make([]matrix.Matrix, 3)
 	for i := 0; i < len(pad); i++ {
-		if i < len(pad) {
+		if i < len(pts) {
 			pad[i] = pts[i]
 		} else {
 			pad[i] = pts[0]
@@ -147,7 +147,7 @@ func (c *Curve) AddLineEndCap(p0, p1 matrix.Matrix, distance float64) {
 		c.Add(offsetR.P1)
 	case calc.CAPSQUARE:
 		// add a square defined by extensions of the offset segment endpoints
-		squareCapSideOffset := matrix.Matrix{}
+		squareCapSideOffset := matrix.Matrix{0, 0}
 		squareCapSideOffset[0] = math.Abs(distance) * math.Cos(angle)
 		squareCapSideOffset[1] = math.Abs(distance) * math.Sin(angle)
 
@@ -400,14 +400,13 @@ func (c *Curve) addMitreJoin(p matrix.Matrix,
 	// This computation is unstable if the offset segments are nearly collinear.
 	// However, this situation should have been eliminated earlier by the check
 	// for whether the offset segment endpoints are almost coincident
-	_, intPt := relate.Intersection(c.offset0.P0, c.offset0.P1, offset1.P0, offset1.P1)
-	if intPt != nil {
+	if intPt, ok := lineIntersection(offset0.P0, offset0.P1, offset1.P0, offset1.P1); ok {
 		mitreRatio := 1.0
 		if distance > 0.0 {
-			mitreRatio = measure.PlanarDistance(intPt[0].Matrix, p) / math.Abs(distance)
+			mitreRatio = measure.PlanarDistance(intPt, p) / math.Abs(distance)
 		}
 		if mitreRatio <= c.parameters.MitreLimit {
-			c.Add(intPt[0].Matrix)
+			c.Add(intPt)
 			return
 		}
 	}
@@ -416,6 +415,18 @@ func (c *Curve) addMitreJoin(p matrix.Matrix,
 	//      addBevelJoin(offset0, offset1);
 }
 
+// lineIntersection returns the intersection of the lines through p1p2 and q1q2, false if they are parallel.
+func lineIntersection(p1, p2, q1, q2 matrix.Matrix) (matrix.Matrix, bool) {
+	dpx, dpy := p2[0]-p1[0], p2[1]-p1[1]
+	dqx, dqy := q2[0]-q1[0], q2[1]-q1[1]
+	denom := dpx*dqy - dpy*dqx
+	if denom == 0 {
+		return nil, false
+	}
+	t := ((q1[0]-p1[0])*dqy - (q1[1]-p1[1])*dqx) / denom
+	return matrix.Matrix{p1[0] + t*dpx, p1[1] + t*dpy}, true
+}
+
 // Adds a limited mitre join connecting the two reflex offset segments.
 // A limited mitre is a mitre which is beveled at the distance
 // determined by the mitre ratio limit.
@@ -438,31 +449,18 @@ func (c *Curve) addLimitedMitreJoin(
 
 	// the miterLimit determines the distance to the mitre bevel
 	mitreDist := mitreLimit * distance
-	// the bevel delta is the difference between the buffer distance
-	// and half of the length of the bevel segment
-	bevelDelta := mitreDist * math.Abs(math.Sin(angDiffHalf))
-	bevelHalfLen := distance - bevelDelta
-
-	// compute the midpoint of the bevel segment
-	bevelMidX := basePt[0] + mitreDist*math.Cos(mitreMidAng)
-	bevelMidY := basePt[1] + mitreDist*math.Sin(mitreMidAng)
-	bevelMidPt := matrix.Matrix{bevelMidX, bevelMidY}
-
-	// compute the mitre midline segment from the corner point to the bevel segment midpoint
-	mitreMidLine := matrix.LineSegment{P0: basePt, P1: bevelMidPt}
-
-	// finally the bevel segment endpoints are computed as offsets from
-	// the mitre midline
-	bevelEndLeft, _ := mitreMidLine.PointAlongOffset(1.0, bevelHalfLen)
-	bevelEndRight, _ := mitreMidLine.PointAlongOffset(1.0, -bevelHalfLen)
-
-	if c.side == calc.LEFT {
-		c.Add(bevelEndLeft)
-		c.Add(bevelEndRight)
-	} else {
-		c.Add(bevelEndRight)
-		c.Add(bevelEndLeft)
+	// the bevel segment is perpendicular to the bisector, its endpoints are on the offset segments
+	bevelMidPt := matrix.Matrix{basePt[0] + mitreDist*math.Cos(mitreMidAng), basePt[1] + mitreDist*math.Sin(mitreMidAng)}
+	bevelDirPt := matrix.Matrix{bevelMidPt[0] - math.Sin(mitreMidAng), bevelMidPt[1] + math.Cos(mitreMidAng)}
+
+	bevel0, ok0 := lineIntersection(offset0.P0, offset0.P1, bevelMidPt, bevelDirPt)
+	bevel1, ok1 := lineIntersection(offset1.P0, offset1.P1, bevelMidPt, bevelDirPt)
+	if !ok0 || !ok1 {
+		c.addBevelJoin(offset0, offset1)
+		return
 	}
+	c.Add(bevel0)
+	c.Add(bevel1)
 }
 
 // Adds a bevel join connecting the two offset segments
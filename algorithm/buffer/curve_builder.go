@@ -21,7 +21,8 @@ type CurveBuilder struct {
 // fail for closed lines, but will generate superfluous line caps).
 func (c *CurveBuilder) LineCurve(pts matrix.LineMatrix, distance float64,
 	leftLoc, rightLoc int) matrix.LineMatrix {
-	c.distance = distance
+	c.distance = math.Abs(distance)
+	c.Curve.Line = nil
 
 	if len(pts) <= 1 {
 		c.computePointCurve(pts[0])
@@ -57,7 +58,11 @@ func (c *CurveBuilder) RingCurve(pts matrix.LineMatrix, distance float64, side i
 		return copy
 	}
 
+	c.Curve.Line = nil
 	c.computeRingBufferCurve(pts, side)
+	if c.isRingCurveInverted(pts, distance) {
+		return nil
+	}
 	lineCoord := c.Curve.Line
 
 	c.AddCurve(lineCoord, leftLoc, rightLoc)
@@ -108,9 +113,9 @@ func (c *CurveBuilder) isRingCurveInverted(pts matrix.LineMatrix, distance float
 		return false
 	}
 
-	// An inverted curve has no more points than the input ring.
-	// This also eliminates concave inputs (which will produce fillet arcs)
-	if len(c.Curves) > len(pts) {
+	// An inverted curve has a few points for each point of the input ring,
+	// the offset endpoints and the vertex of each inside turn or a short fillet.
+	if len(c.Curve.Line) > calc.InvertedCurveVertexFactor*len(pts) {
 		return false
 	}
 
@@ -196,13 +201,15 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 
 		// since we are traversing line in opposite order, offset position is still LEFT
 		c.Curve.initSideSegments(simp2[n2], simp2[n2-1], calc.LEFT)
-		c.Curve.Add(c.Curve.offset1.P1)
+		c.Curve.Add(c.Curve.offset1.P0)
 		for i := n2 - 2; i >= 0; i-- {
 			c.Curve.addNextSegment(simp2[i], true)
 		}
 	} else {
-		// add original line
-		c.Curve.AddLine(pts)
+		// add original line in reverse
+		for i := len(pts) - 1; i >= 0; i-- {
+			c.Curve.AddPt(pts[i])
+		}
 
 		//--------- compute points for left side of line
 		// Simplify the appropriate side of the line before generating
@@ -213,7 +220,7 @@ func (c *CurveBuilder) computeSingleSidedBufferCurve(pts matrix.LineMatrix, isRi
 		//      Coordinate[] simp1 = inputPts;
 		n1 := len(simp1) - 1
 		c.Curve.initSideSegments(simp1[0], simp1[1], calc.LEFT)
-		c.Curve.Add(c.Curve.offset1.P1)
+		c.Curve.Add(c.Curve.offset1.P0)
 		for i := 2; i <= n1; i++ {
 			c.Curve.addNextSegment(simp1[i], true)
 		}
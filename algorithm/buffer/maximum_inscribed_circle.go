@@ -0,0 +1,137 @@
+package buffer
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// MaximumInscribedCircle Computes the pole of inaccessibility of the polygon,
+// the interior point farthest from the boundary, as the center of the largest circle inside the polygon.
+// The point is found by subdividing the envelope of the polygon into square cells, kept in a queue
+// by the largest distance reachable inside them, until no cell can improve the distance by more than the tolerance.
+// A tolerance not greater than 0 is replaced by a ten thousandth of the extent of the polygon.
+// For a multi polygon the part with the largest circle is chosen.
+// It returns the center and the radius of the circle, a nil center if the geometry has no area.
+func MaximumInscribedCircle(geom matrix.Steric, tolerance float64) (matrix.Matrix, float64) {
+	switch g := geom.(type) {
+	case matrix.PolygonMatrix:
+		return poleOfInaccessibility(g, tolerance)
+	case matrix.MultiPolygonMatrix:
+		var center matrix.Matrix
+		radius := 0.0
+		for _, v := range g {
+			if c, r := poleOfInaccessibility(v, tolerance); c != nil && (center == nil || r > radius) {
+				center, radius = c, r
+			}
+		}
+		return center, radius
+	case matrix.Collection:
+		var center matrix.Matrix
+		radius := 0.0
+		for _, v := range g {
+			if c, r := MaximumInscribedCircle(v, tolerance); c != nil && (center == nil || r > radius) {
+				center, radius = c, r
+			}
+		}
+		return center, radius
+	}
+	return nil, 0
+}
+
+// inscribedCell a square cell of the subdivision, with the signed distance of its center to the boundary.
+type inscribedCell struct {
+	center matrix.Matrix
+	half   float64
+	dist   float64
+	// max is the largest distance to the boundary of a point in the cell.
+	max float64
+}
+
+func newInscribedCell(x, y, half float64, poly matrix.PolygonMatrix) inscribedCell {
+	center := matrix.Matrix{x, y}
+	dist := signedDistance(center, poly)
+	return inscribedCell{center: center, half: half, dist: dist, max: dist + half*math.Sqrt2}
+}
+
+func poleOfInaccessibility(poly matrix.PolygonMatrix, tolerance float64) (matrix.Matrix, float64) {
+	if len(poly) == 0 || len(poly[0]) < 4 {
+		return nil, 0
+	}
+	env := envelope.Matrix(poly[0][0])
+	for _, v := range poly[0][1:] {
+		env.ExpandToIncludeMatrix(v)
+	}
+	if math.Min(env.Width(), env.Height()) == 0 {
+		return nil, 0
+	}
+	size := math.Max(env.Width(), env.Height())
+	if tolerance <= 0 {
+		tolerance = size * 1e-4
+	}
+
+	// a single cell covers the envelope, a grid of cells of the smaller side is too large for a long thin polygon.
+	queue := &cellQueue{}
+	heap.Push(queue, newInscribedCell((env.MinX+env.MaxX)/2, (env.MinY+env.MaxY)/2, size/2, poly))
+	// the centroid and the center of the envelope are good first guesses
+	best := newInscribedCell((env.MinX+env.MaxX)/2, (env.MinY+env.MaxY)/2, 0, poly)
+	if c := Centroid(poly); c != nil {
+		if cell := newInscribedCell(c[0], c[1], 0, poly); cell.dist > best.dist {
+			best = cell
+		}
+	}
+	for queue.Len() > 0 {
+		cell := heap.Pop(queue).(inscribedCell)
+		if cell.dist > best.dist {
+			best = cell
+		}
+		if cell.max-best.dist <= tolerance {
+			continue
+		}
+		h := cell.half / 2
+		heap.Push(queue, newInscribedCell(cell.center[0]-h, cell.center[1]-h, h, poly))
+		heap.Push(queue, newInscribedCell(cell.center[0]+h, cell.center[1]-h, h, poly))
+		heap.Push(queue, newInscribedCell(cell.center[0]-h, cell.center[1]+h, h, poly))
+		heap.Push(queue, newInscribedCell(cell.center[0]+h, cell.center[1]+h, h, poly))
+	}
+	if best.dist < 0 {
+		return nil, 0
+	}
+	return best.center, best.dist
+}
+
+// signedDistance returns the distance of the point to the boundary of the polygon, negative if the point is outside.
+func signedDistance(p matrix.Matrix, poly matrix.PolygonMatrix) float64 {
+	inside := false
+	dist := math.MaxFloat64
+	for _, ring := range poly {
+		for i := 0; i < len(ring)-1; i++ {
+			a, b := ring[i], ring[i+1]
+			if (a[1] > p[1]) != (b[1] > p[1]) && p[0] < (b[0]-a[0])*(p[1]-a[1])/(b[1]-a[1])+a[0] {
+				inside = !inside
+			}
+		}
+		dist = math.Min(dist, measure.DistanceLineToPoint(ring, p, measure.PlanarDistance))
+	}
+	if inside {
+		return dist
+	}
+	return -dist
+}
+
+// cellQueue a priority queue of the cells, the largest reachable distance first.
+type cellQueue []inscribedCell
+
+func (q cellQueue) Len() int            { return len(q) }
+func (q cellQueue) Less(i, j int) bool  { return q[i].max > q[j].max }
+func (q cellQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *cellQueue) Push(x interface{}) { *q = append(*q, x.(inscribedCell)) }
+func (q *cellQueue) Pop() interface{} {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return item
+}
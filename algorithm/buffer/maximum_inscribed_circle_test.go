@@ -0,0 +1,58 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMaximumInscribedCircle(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name   string
+		geom   matrix.Steric
+		center matrix.Matrix
+		radius float64
+	}{
+		{name: "square", geom: square, center: matrix.Matrix{5, 5}, radius: 5},
+		{name: "rectangle", geom: matrix.PolygonMatrix{{{0, 0}, {20, 0}, {20, 4}, {0, 4}, {0, 0}}}, radius: 2},
+		{name: "L shape", geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 2}, {2, 2}, {2, 10}, {0, 10}, {0, 0}}}, radius: 4 - 2*math.Sqrt2},
+		{name: "square with hole", geom: matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+		}, radius: 4 - 2*math.Sqrt2},
+		{name: "multi polygon", geom: matrix.MultiPolygonMatrix{
+			{{{20, 0}, {22, 0}, {22, 2}, {20, 2}, {20, 0}}},
+			square,
+		}, center: matrix.Matrix{5, 5}, radius: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			center, radius := MaximumInscribedCircle(tt.geom, 1e-4)
+			if math.Abs(radius-tt.radius) > 1e-4 {
+				t.Errorf("MaximumInscribedCircle() radius = %v, want %v", radius, tt.radius)
+			}
+			if tt.center != nil && !center.EqualsExact(tt.center, 1e-4) {
+				t.Errorf("MaximumInscribedCircle() center = %v, want %v", center, tt.center)
+			}
+		})
+	}
+	for _, height := range []float64{1e-4, 1e-6} {
+		thin := matrix.PolygonMatrix{{{0, 0}, {1000, 0}, {1000, height}, {0, height}, {0, 0}}}
+		center, radius := MaximumInscribedCircle(thin, 0)
+		if center == nil || radius <= 0 || radius > height/2*(1+1e-9) || center[1] <= 0 || center[1] >= height {
+			t.Errorf("MaximumInscribedCircle() long thin %v = %v, %v", height, center, radius)
+		}
+	}
+
+	// the circle of a U shape is in the larger arm, where the interior point of the scan line is not
+	u := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {8, 10}, {8, 2}, {4, 2}, {4, 10}, {0, 10}, {0, 0}}}
+	center, radius := MaximumInscribedCircle(u, 0)
+	if math.Abs(radius-2) > 1e-3 || math.Abs(center[0]-2) > 1e-3 {
+		t.Errorf("MaximumInscribedCircle() U shape = %v, %v", center, radius)
+	}
+	if center, radius := MaximumInscribedCircle(matrix.LineMatrix{{0, 0}, {1, 1}}, 0); center != nil || radius != 0 {
+		t.Errorf("MaximumInscribedCircle() line = %v, %v, want nil", center, radius)
+	}
+}
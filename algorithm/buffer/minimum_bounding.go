@@ -0,0 +1,175 @@
+package buffer
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// MinimumBoundingCircle Computes the smallest circle which contains all the vertices of the geometry,
+// from the vertices of the convex hull with the algorithm of Welzl.
+// It returns the center and the radius of the circle, a nil center for an empty geometry.
+func MinimumBoundingCircle(geom matrix.Steric) (matrix.Matrix, float64) {
+	pts := hullPoints(geom)
+	if len(pts) == 0 {
+		return nil, 0
+	}
+	// the points in random order give the expected linear time
+	rand.New(rand.NewSource(1)).Shuffle(len(pts), func(i, j int) { pts[i], pts[j] = pts[j], pts[i] })
+	center, radius := pts[0], 0.0
+	outside := func(p matrix.Matrix) bool {
+		return math.Sqrt(graph.SquareDistance(center, p)) > radius*(1+1e-12)
+	}
+	for i := 1; i < len(pts); i++ {
+		if !outside(pts[i]) {
+			continue
+		}
+		center, radius = pts[i], 0
+		for j := 0; j < i; j++ {
+			if !outside(pts[j]) {
+				continue
+			}
+			center = matrix.Matrix{(pts[i][0] + pts[j][0]) / 2, (pts[i][1] + pts[j][1]) / 2}
+			radius = math.Sqrt(graph.SquareDistance(center, pts[i]))
+			for k := 0; k < j; k++ {
+				if !outside(pts[k]) {
+					continue
+				}
+				center, radius = circle(pts[i], pts[j], pts[k])
+			}
+		}
+	}
+	return matrix.Matrix{center[0], center[1]}, radius
+}
+
+// MinimumRotatedRectangle Computes the rectangle of the smallest area which contains all the vertices of the geometry,
+// the rectangle is rotated to the direction of an edge of the convex hull, found by rotating calipers over the convex hull.
+// It returns a polygon, or the line or the point of the convex hull if the vertices are collinear or equal.
+func MinimumRotatedRectangle(geom matrix.Steric) matrix.Steric {
+	pts := hullPoints(geom)
+	if len(pts) < 3 {
+		return ConvexHullWithGeom(geom).ConvexHull()
+	}
+	n := len(pts)
+	dot := func(p, u matrix.Matrix) float64 { return p[0]*u[0] + p[1]*u[1] }
+	// advance moves the index forward while the projection of the next point does not decrease.
+	advance := func(j int, project func(p matrix.Matrix) float64) int {
+		for k := 0; k < n && project(pts[(j+1)%n]) >= project(pts[j]); k++ {
+			j = (j + 1) % n
+		}
+		return j
+	}
+	var rect matrix.LineMatrix
+	minArea := math.MaxFloat64
+	maxU, maxV, minU := 1, 1, 1
+	for i := 0; i < n; i++ {
+		p, q := pts[i], pts[(i+1)%n]
+		length := math.Sqrt(graph.SquareDistance(p, q))
+		u := matrix.Matrix{(q[0] - p[0]) / length, (q[1] - p[1]) / length}
+		v := matrix.Matrix{-u[1], u[0]}
+		maxU = advance(maxU, func(p matrix.Matrix) float64 { return dot(p, u) })
+		maxV = advance(maxV, func(p matrix.Matrix) float64 { return dot(p, v) })
+		if i == 0 {
+			minU = maxV
+		}
+		minU = advance(minU, func(p matrix.Matrix) float64 { return -dot(p, u) })
+
+		u0, u1 := dot(pts[minU], u), dot(pts[maxU], u)
+		v0, v1 := dot(p, v), dot(pts[maxV], v)
+		if area := (u1 - u0) * (v1 - v0); area < minArea {
+			minArea = area
+			corner := func(a, b float64) []float64 { return []float64{a*u[0] + b*v[0], a*u[1] + b*v[1]} }
+			rect = matrix.LineMatrix{corner(u0, v0), corner(u1, v0), corner(u1, v1), corner(u0, v1), corner(u0, v0)}
+		}
+	}
+	return matrix.PolygonMatrix{rect}
+}
+
+// MinimumWidth Computes the minimum width, or minimum diameter, of the geometry,
+// the smallest distance between two parallel lines which enclose all the vertices,
+// found by rotating calipers over the convex hull.
+// It returns the line from the projection of the vertex on the opposite edge to the vertex,
+// its length is the width, a nil line for an empty geometry.
+func MinimumWidth(geom matrix.Steric) matrix.LineMatrix {
+	pts := hullPoints(geom)
+	switch len(pts) {
+	case 0:
+		return nil
+	case 1, 2:
+		return matrix.LineMatrix{pts[0], pts[0]}
+	}
+	n := len(pts)
+	var width matrix.LineMatrix
+	minWidth := math.MaxFloat64
+	j := 1
+	for i := 0; i < n; i++ {
+		p, q := pts[i], pts[(i+1)%n]
+		// the distance to the edge line, the hull is counter clockwise
+		dist := func(r matrix.Matrix) float64 {
+			return ((q[0]-p[0])*(r[1]-p[1]) - (q[1]-p[1])*(r[0]-p[0])) / math.Sqrt(graph.SquareDistance(p, q))
+		}
+		for k := 0; k < n && dist(pts[(j+1)%n]) >= dist(pts[j]); k++ {
+			j = (j + 1) % n
+		}
+		if d := dist(pts[j]); d < minWidth {
+			minWidth = d
+			width = matrix.LineMatrix{projectPoint(pts[j], p, q), pts[j]}
+		}
+	}
+	return width
+}
+
+// hullPoints returns the vertices of the convex hull of the geometry, counter clockwise and without the closing point.
+func hullPoints(geom matrix.Steric) []matrix.Matrix {
+	switch hull := ConvexHullWithGeom(geom).ConvexHull().(type) {
+	case matrix.Matrix:
+		return []matrix.Matrix{hull}
+	case matrix.LineMatrix:
+		return []matrix.Matrix{hull[0], hull[len(hull)-1]}
+	case matrix.PolygonMatrix:
+		ring := hull[0]
+		if measure.AreaDirection(ring) > 0 {
+			ring = graph.Reverse(ring)
+		}
+		pts := make([]matrix.Matrix, 0, len(ring)-1)
+		for _, v := range ring[:len(ring)-1] {
+			pts = append(pts, v)
+		}
+		return pts
+	}
+	return nil
+}
+
+// circle returns the center and the radius of the circle through the three points,
+// or of the circle on the farthest two points if they are collinear.
+func circle(a, b, c matrix.Matrix) (matrix.Matrix, float64) {
+	bx, by := b[0]-a[0], b[1]-a[1]
+	cx, cy := c[0]-a[0], c[1]-a[1]
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		p, q := a, b
+		if graph.SquareDistance(a, c) > graph.SquareDistance(p, q) {
+			p, q = a, c
+		}
+		if graph.SquareDistance(b, c) > graph.SquareDistance(p, q) {
+			p, q = b, c
+		}
+		center := matrix.Matrix{(p[0] + q[0]) / 2, (p[1] + q[1]) / 2}
+		return center, math.Sqrt(graph.SquareDistance(center, p))
+	}
+	b2, c2 := bx*bx+by*by, cx*cx+cy*cy
+	center := matrix.Matrix{a[0] + (cy*b2-by*c2)/d, a[1] + (bx*c2-cx*b2)/d}
+	radius := math.Max(math.Sqrt(graph.SquareDistance(center, a)),
+		math.Max(math.Sqrt(graph.SquareDistance(center, b)), math.Sqrt(graph.SquareDistance(center, c))))
+	return center, radius
+}
+
+// projectPoint returns the projection of the point on the line through p and q.
+func projectPoint(r, p, q matrix.Matrix) matrix.Matrix {
+	dx, dy := q[0]-p[0], q[1]-p[1]
+	f := ((r[0]-p[0])*dx + (r[1]-p[1])*dy) / (dx*dx + dy*dy)
+	return matrix.Matrix{p[0] + f*dx, p[1] + f*dy}
+}
@@ -0,0 +1,110 @@
+package buffer
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestMinimumBoundingCircle(t *testing.T) {
+	tests := []struct {
+		name   string
+		geom   matrix.Steric
+		center matrix.Matrix
+		radius float64
+	}{
+		{name: "square", geom: matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, center: matrix.Matrix{1, 1}, radius: math.Sqrt2},
+		{name: "obtuse triangle", geom: matrix.LineMatrix{{0, 0}, {10, 0}, {5, 1}}, center: matrix.Matrix{5, 0}, radius: 5},
+		{name: "acute triangle", geom: matrix.LineMatrix{{0, 0}, {6, 0}, {3, 4}},
+			center: matrix.Matrix{3, 0.875}, radius: 3.125},
+		{name: "collinear", geom: matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}, center: matrix.Matrix{1, 1}, radius: math.Sqrt2},
+		{name: "point", geom: matrix.Matrix{3, 4}, center: matrix.Matrix{3, 4}, radius: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			center, radius := MinimumBoundingCircle(tt.geom)
+			if !center.EqualsExact(tt.center, 0.000001) || math.Abs(radius-tt.radius) > 0.000001 {
+				t.Errorf("MinimumBoundingCircle() = %v %v, want %v %v", center, radius, tt.center, tt.radius)
+			}
+		})
+	}
+	if center, _ := MinimumBoundingCircle(matrix.Collection{}); center != nil {
+		t.Errorf("MinimumBoundingCircle() empty = %v, want nil", center)
+	}
+}
+
+func TestMinimumRotatedRectangle(t *testing.T) {
+	diamond := matrix.PolygonMatrix{{{0, 0}, {2, 2}, {0, 4}, {-2, 2}, {0, 0}}}
+	got := MinimumRotatedRectangle(diamond).(matrix.PolygonMatrix)
+	if area := polygonArea(got); math.Abs(area-8) > 1e-9 {
+		t.Errorf("MinimumRotatedRectangle() = %v, want area 8", got)
+	}
+	if got := MinimumRotatedRectangle(matrix.LineMatrix{{0, 0}, {1, 1}, {2, 2}}); !got.Equals(matrix.LineMatrix{{0, 0}, {2, 2}}) {
+		t.Errorf("MinimumRotatedRectangle() collinear = %v", got)
+	}
+}
+
+func TestMinimumWidth(t *testing.T) {
+	tests := []struct {
+		name string
+		geom matrix.Steric
+		want matrix.LineMatrix
+	}{
+		{name: "rectangle", geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 2}, {0, 2}, {0, 0}}}, want: matrix.LineMatrix{{0, 0}, {0, 2}}},
+		{name: "triangle", geom: matrix.LineMatrix{{0, 0}, {10, 0}, {5, 1}}, want: matrix.LineMatrix{{5, 0}, {5, 1}}},
+		{name: "line", geom: matrix.LineMatrix{{0, 0}, {1, 1}}, want: matrix.LineMatrix{{0, 0}, {0, 0}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MinimumWidth(tt.geom); !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("MinimumWidth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestMinimumBounding compares the rotating calipers with all the edges of the convex hull of rotated random points.
+func TestMinimumBounding(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for trial := 0; trial < 20; trial++ {
+		pts := matrix.Collection{}
+		angle := r.Float64() * math.Pi
+		for i := 0; i < 100; i++ {
+			x, y := r.Float64()*100, r.Float64()*30
+			pts = append(pts, matrix.Matrix{x*math.Cos(angle) - y*math.Sin(angle), x*math.Sin(angle) + y*math.Cos(angle)})
+		}
+		hull := ConvexHullWithGeom(pts).ConvexHull().(matrix.PolygonMatrix)
+		center, radius := MinimumBoundingCircle(pts)
+		rect := MinimumRotatedRectangle(pts).(matrix.PolygonMatrix)
+		for _, v := range pts {
+			p := v.(matrix.Matrix)
+			if !coveredBy(p, hull) || !coveredBy(p, rect) || math.Sqrt(graph.SquareDistance(center, p)) > radius*(1+1e-9) {
+				t.Fatalf("point %v is not covered", p)
+			}
+		}
+
+		pts2 := hullPoints(pts)
+		minArea, minWidth := math.MaxFloat64, math.MaxFloat64
+		for i := range pts2 {
+			p, q := pts2[i], pts2[(i+1)%len(pts2)]
+			l := math.Sqrt(graph.SquareDistance(p, q))
+			u0, u1, v0, v1 := math.MaxFloat64, -math.MaxFloat64, math.MaxFloat64, -math.MaxFloat64
+			for _, w := range pts2 {
+				a := (w[0]*(q[0]-p[0]) + w[1]*(q[1]-p[1])) / l
+				b := (w[1]*(q[0]-p[0]) - w[0]*(q[1]-p[1])) / l
+				u0, u1, v0, v1 = math.Min(u0, a), math.Max(u1, a), math.Min(v0, b), math.Max(v1, b)
+			}
+			minArea, minWidth = math.Min(minArea, (u1-u0)*(v1-v0)), math.Min(minWidth, v1-v0)
+		}
+		if area := polygonArea(rect); math.Abs(area-minArea) > 1e-6 {
+			t.Errorf("MinimumRotatedRectangle() area = %v, want %v", area, minArea)
+		}
+		width := MinimumWidth(pts)
+		if w := math.Sqrt(graph.SquareDistance(width[0], width[1])); math.Abs(w-minWidth) > 1e-6 {
+			t.Errorf("MinimumWidth() = %v, want %v", w, minWidth)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package buffer
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// OffsetCurve Computes the offset curve of a line, the line displaced by the distance
+// to the left for a positive distance and to the right for a negative distance, in the direction of the line.
+// The joins of the curve follow the join style and mitre limit of the parameters, the end cap style and single sided flag are ignored.
+// Loops of the curve at tight bends, which lie closer to the line than the distance, are removed,
+// so the curve may break up into several lines.
+// The lines of a collection are offset one by one, other geometries have no offset curve.
+func OffsetCurve(geom matrix.Steric, distance float64, param *CurveParameters) matrix.Steric {
+	if param == nil || param.IsEmpty() {
+		param = DefaultCurveParameters()
+	}
+	result := matrix.Collection{}
+	switch st := geom.(type) {
+	case matrix.LineMatrix:
+		for _, v := range offsetLine(st, distance, param) {
+			result = append(result, v)
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			switch c := OffsetCurve(v, distance, param).(type) {
+			case matrix.LineMatrix:
+				result = append(result, c)
+			case matrix.Collection:
+				result = append(result, c...)
+			}
+		}
+	}
+	switch len(result) {
+	case 0:
+		return nil
+	case 1:
+		return result[0]
+	}
+	return result
+}
+
+// offsetLine returns the sections of the boundary of the buffer of the line which lie on the raw offset curve of the line,
+// in the direction and order of the line.
+func offsetLine(line matrix.LineMatrix, distance float64, param *CurveParameters) []matrix.LineMatrix {
+	line = graph.RemoveRepeated(line)
+	if len(line) < 2 {
+		return nil
+	}
+	if distance == 0 {
+		return []matrix.LineMatrix{line}
+	}
+	raw := rawOffsetCurve(line, distance, param)
+
+	flat := *param
+	flat.EndCapStyle, flat.IsSingleSided = calc.CAPFLAT, false
+	rings := []matrix.LineMatrix{}
+	switch b := BufferWithParams(line, math.Abs(distance), &flat).(type) {
+	case matrix.PolygonMatrix:
+		for _, v := range b {
+			rings = append(rings, v)
+		}
+	case matrix.Collection:
+		for _, p := range b {
+			for _, v := range p.(matrix.PolygonMatrix) {
+				rings = append(rings, v)
+			}
+		}
+	}
+
+	sections := []matrix.LineMatrix{}
+	for _, ring := range rings {
+		sections = append(sections, ringSections(ring, raw)...)
+	}
+	for i, v := range sections {
+		if curvePosition(raw, segmentPoint(v, 0, 0.25)) > curvePosition(raw, segmentPoint(v, len(v)-2, 0.75)) {
+			sections[i] = graph.Reverse(v)
+		}
+	}
+	sort.SliceStable(sections, func(i, j int) bool {
+		return curvePosition(raw, segmentPoint(sections[i], 0, 0.5)) < curvePosition(raw, segmentPoint(sections[j], 0, 0.5))
+	})
+	return sections
+}
+
+// ringSections returns the runs of segments of the ring which lie on the curve.
+func ringSections(ring, curve matrix.LineMatrix) []matrix.LineMatrix {
+	n := len(ring) - 1
+	onCurve := make([]bool, n)
+	start := -1
+	for i := 0; i < n; i++ {
+		onCurve[i] = graph.OnLine(segmentPoint(ring, i, 0.5), curve)
+	}
+	for i := 0; i < n; i++ {
+		if onCurve[i] && !onCurve[(i+n-1)%n] {
+			start = i
+			break
+		}
+	}
+	if start < 0 {
+		if n > 0 && onCurve[0] {
+			return []matrix.LineMatrix{ring}
+		}
+		return nil
+	}
+	sections := []matrix.LineMatrix{}
+	var section matrix.LineMatrix
+	for k := 0; k < n; k++ {
+		i := (start + k) % n
+		if !onCurve[i] {
+			if section != nil {
+				sections = append(sections, section)
+			}
+			section = nil
+			continue
+		}
+		if section == nil {
+			section = matrix.LineMatrix{ring[i]}
+		}
+		section = append(section, ring[i+1])
+	}
+	if section != nil {
+		sections = append(sections, section)
+	}
+	return sections
+}
+
+// curvePosition returns the position of the point along the curve, the index of the segment it lies on plus the fraction along it.
+func curvePosition(curve matrix.LineMatrix, pt matrix.Matrix) float64 {
+	for i := 0; i < len(curve)-1; i++ {
+		if graph.OnSegment(pt, curve[i], curve[i+1]) {
+			len2 := graph.SquareDistance(curve[i], curve[i+1])
+			return float64(i) + math.Sqrt(graph.SquareDistance(curve[i], pt)/len2)
+		}
+	}
+	return math.MaxFloat64
+}
+
+// segmentPoint returns the point at the fraction along the i-th segment of the line.
+func segmentPoint(line matrix.LineMatrix, i int, fraction float64) matrix.Matrix {
+	p, q := line[i], line[i+1]
+	return matrix.Matrix{p[0] + fraction*(q[0]-p[0]), p[1] + fraction*(q[1]-p[1])}
+}
+
+// rawOffsetCurve returns the offset segments of the line on one side joined by the join style,
+// the curve runs in the direction of the line.
+func rawOffsetCurve(line matrix.LineMatrix, distance float64, param *CurveParameters) matrix.LineMatrix {
+	c := CurveWithParameters(param, math.Abs(distance))
+	distTol := math.Abs(distance) * param.SimplifyFactor
+	simp := &LineSimplifier{inputLine: line}
+	if distance > 0 {
+		pts := simp.Simplify(distTol)
+		c.initSideSegments(pts[0], pts[1], calc.LEFT)
+		c.Add(c.offset1.P0)
+		for i := 2; i < len(pts); i++ {
+			c.addNextSegment(pts[i], true)
+		}
+		c.Add(c.offset1.P1)
+		return c.Line
+	}
+	// the right side is the left side of the reversed line, as the buffer curve is computed
+	pts := simp.Simplify(-distTol)
+	n := len(pts) - 1
+	c.initSideSegments(pts[n], pts[n-1], calc.LEFT)
+	c.Add(c.offset1.P0)
+	for i := n - 2; i >= 0; i-- {
+		c.addNextSegment(pts[i], true)
+	}
+	c.Add(c.offset1.P1)
+	for i, j := 0, len(c.Line)-1; i < j; i, j = i+1, j-1 {
+		c.Line[i], c.Line[j] = c.Line[j], c.Line[i]
+	}
+	return c.Line
+}
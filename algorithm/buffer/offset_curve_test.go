@@ -0,0 +1,49 @@
+package buffer
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestOffsetCurve(t *testing.T) {
+	round := &CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPROUND, JoinStyle: calc.JOINROUND, MitreLimit: 5, SimplifyFactor: 0.01}
+	mitre := &CurveParameters{QuadrantSegments: 2, EndCapStyle: calc.CAPROUND, JoinStyle: calc.JOINMITRE, MitreLimit: 5, SimplifyFactor: 0.01}
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	spike := matrix.LineMatrix{{0, 0}, {10, 0}, {10.5, 1}, {11, 0}, {20, 0}}
+	type args struct {
+		geom     matrix.Steric
+		distance float64
+		param    *CurveParameters
+	}
+	tests := []struct {
+		name string
+		args args
+		want matrix.Steric
+	}{
+		{name: "left", args: args{line, 1, round}, want: matrix.LineMatrix{{0, 1}, {9, 1}, {9, 10}}},
+		{name: "right round", args: args{line, -1, round},
+			want: matrix.LineMatrix{{0, -1}, {10, -1}, {10.707106781186548, -0.7071067811865475}, {11, 0}, {11, 10}}},
+		{name: "right mitre", args: args{line, -1, mitre}, want: matrix.LineMatrix{{0, -1}, {11, -1}, {11, 10}}},
+		{name: "zero distance", args: args{line, 0, round}, want: line},
+		{name: "u turn inside", args: args{matrix.LineMatrix{{0, 0}, {10, 0}, {10, 4}, {0, 4}}, 1, mitre},
+			want: matrix.LineMatrix{{0, 1}, {9, 1}, {9, 3}, {0, 3}}},
+		{name: "spike loops removed", args: args{spike, -2, mitre},
+			want: matrix.LineMatrix{{0, -2}, {9.76393202250021, -2}, {11.23606797749979, -2}, {20, -2}}},
+		{name: "spike mitre", args: args{spike, 2, mitre},
+			want: matrix.LineMatrix{{0, 2}, {8.76393202250021, 2}, {10.5, 5.472135954999578}, {12.23606797749979, 2}, {20, 2}}},
+		{name: "multi line", args: args{matrix.Collection{line, matrix.LineMatrix{{0, 5}, {5, 5}}}, 1, mitre},
+			want: matrix.Collection{matrix.LineMatrix{{0, 1}, {9, 1}, {9, 10}}, matrix.LineMatrix{{0, 6}, {5, 6}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OffsetCurve(tt.args.geom, tt.args.distance, tt.args.param); !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("OffsetCurve() = %v,\n want %v", got, tt.want)
+			}
+		})
+	}
+	if got := OffsetCurve(matrix.LineMatrix{{0, 0}, {10, 0}, {10, 1}, {0, 1}}, 1, mitre); got != nil {
+		t.Errorf("OffsetCurve() collapsed = %v, want nil", got)
+	}
+}
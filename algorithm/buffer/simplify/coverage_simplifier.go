@@ -0,0 +1,176 @@
+package simplify
+
+import (
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// CoverageSimplify Simplifies a polygonal coverage, polygons tiling an area which meet along shared edges
+// with the same vertices, without opening gaps or overlaps between them.
+// The rings are split into edges at the vertices where other than two segments meet,
+// each edge is simplified once with the Visvalingam-Whyatt algorithm and the area tolerance,
+// and the polygons are rebuilt from the simplified edges.
+// The ends of the edges and three vertices of each ring are kept, and a vertex is not removed
+// while another vertex of the coverage lies in its triangle, so the edges do not cross.
+func CoverageSimplify(coverage []matrix.PolygonMatrix, areaTolerance float64) []matrix.PolygonMatrix {
+	c := &coverageEdges{index: map[[4]float64]int{}}
+	c.build(coverage)
+
+	vw := &vwSimplifier{}
+	for i, edge := range c.edges {
+		if c.closed[i] {
+			vw.addPart(edge, true, 3, true)
+		} else {
+			vw.addPart(edge, false, 2, true)
+		}
+	}
+	// the rings of several edges keep three vertices
+	for _, poly := range c.rings {
+		for _, ring := range poly {
+			if len(ring) == 1 && c.closed[ring[0].edge] {
+				continue
+			}
+			size := 0
+			for _, use := range ring {
+				size += len(c.edges[use.edge]) - 1
+				vw.parts[use.edge].rings = append(vw.parts[use.edge].rings, len(vw.ringSizes))
+			}
+			vw.ringSizes = append(vw.ringSizes, size)
+		}
+	}
+	vw.init()
+	vw.simplify(func(area float64) bool { return area >= areaTolerance })
+
+	result := make([]matrix.PolygonMatrix, len(coverage))
+	for i, poly := range c.rings {
+		for _, ring := range poly {
+			simplified := matrix.LineMatrix{}
+			for _, use := range ring {
+				pts := vw.line(use.edge)
+				if use.reversed {
+					pts = graph.Reverse(pts)
+				}
+				for k, v := range pts {
+					if k == 0 && len(simplified) > 0 {
+						continue
+					}
+					simplified = append(simplified, v)
+				}
+			}
+			result[i] = append(result[i], simplified)
+		}
+	}
+	return result
+}
+
+// edgeUse an edge of a ring, in its direction or reversed.
+type edgeUse struct {
+	edge     int
+	reversed bool
+}
+
+// coverageEdges the edges of a coverage, shared by the rings of the polygons on both sides.
+type coverageEdges struct {
+	edges []matrix.LineMatrix
+	// closed edges are rings without a node.
+	closed []bool
+	// index finds an edge by the key of its smallest segment.
+	index map[[4]float64]int
+	// rings are the edges of the rings of each polygon.
+	rings [][][]edgeUse
+}
+
+func (c *coverageEdges) build(coverage []matrix.PolygonMatrix) {
+	// the degree of a vertex is the number of distinct segments meeting at it
+	degree := map[[2]float64]int{}
+	seen := map[[4]float64]bool{}
+	for _, poly := range coverage {
+		for _, ring := range poly {
+			for i := 0; i < len(ring)-1; i++ {
+				key := graph.SegmentKey(ring[i], ring[i+1])
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				degree[[2]float64{ring[i][0], ring[i][1]}]++
+				degree[[2]float64{ring[i+1][0], ring[i+1][1]}]++
+			}
+		}
+	}
+	isNode := func(p []float64) bool { return degree[[2]float64{p[0], p[1]}] != 2 }
+
+	for _, poly := range coverage {
+		rings := [][]edgeUse{}
+		for _, ring := range poly {
+			rings = append(rings, c.addRing(matrix.LineMatrix(ring), isNode))
+		}
+		c.rings = append(c.rings, rings)
+	}
+}
+
+// addRing splits the ring into edges at its nodes, or keeps it as one closed edge if it has none,
+// and returns the edges of the ring in its order.
+func (c *coverageEdges) addRing(ring matrix.LineMatrix, isNode func(p []float64) bool) []edgeUse {
+	n := len(ring) - 1
+	start := 0
+	for start < n && !isNode(ring[start]) {
+		start++
+	}
+	closed := start == n
+	if closed {
+		start = 0
+	}
+	paths := []matrix.LineMatrix{}
+	path := matrix.LineMatrix{ring[start]}
+	for k := 1; k <= n; k++ {
+		p := ring[(start+k)%n]
+		path = append(path, p)
+		if k == n || isNode(p) {
+			paths = append(paths, path)
+			path = matrix.LineMatrix{p}
+		}
+	}
+	uses := []edgeUse{}
+	for _, path := range paths {
+		key := graph.SegmentKey(path[0], path[1])
+		for i := 1; i < len(path)-1; i++ {
+			if k := graph.SegmentKey(path[i], path[i+1]); lessKey(k, key) {
+				key = k
+			}
+		}
+		i, ok := c.index[key]
+		if !ok {
+			i = len(c.edges)
+			c.index[key] = i
+			c.edges = append(c.edges, path)
+			c.closed = append(c.closed, closed)
+			uses = append(uses, edgeUse{edge: i})
+			continue
+		}
+		uses = append(uses, edgeUse{edge: i, reversed: !sameDirection(c.edges[i], path, closed)})
+	}
+	return uses
+}
+
+// sameDirection returns true if the path runs along the edge in its direction,
+// a closed path may start at another vertex of the edge.
+func sameDirection(edge, path matrix.LineMatrix, closed bool) bool {
+	if !closed {
+		return matrix.Matrix(edge[0]).Equals(matrix.Matrix(path[0])) && matrix.Matrix(edge[1]).Equals(matrix.Matrix(path[1]))
+	}
+	for i := 0; i < len(path)-1; i++ {
+		if matrix.Matrix(path[i]).Equals(matrix.Matrix(edge[0])) {
+			return matrix.Matrix(path[i+1]).Equals(matrix.Matrix(edge[1]))
+		}
+	}
+	return true
+}
+
+func lessKey(a, b [4]float64) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return false
+}
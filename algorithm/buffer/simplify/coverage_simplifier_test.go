@@ -0,0 +1,58 @@
+package simplify
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestCoverageSimplify(t *testing.T) {
+	border := matrix.LineMatrix{{10, 0}, {10.1, 2}, {9.9, 4}, {10.1, 6}, {9.9, 8}, {10, 10}}
+	left := matrix.LineMatrix{{0, 10}, {0.1, 5}, {0, 0}}
+	for _, v := range border {
+		left = append(left, v)
+	}
+	left = append(left, left[0])
+	right := matrix.LineMatrix{{20, 0}, {19.9, 5}, {20, 10}}
+	for i := len(border) - 1; i >= 0; i-- {
+		right = append(right, border[i])
+	}
+	right = append(right, right[0])
+	// an island filling the hole of the left polygon
+	hole := matrix.LineMatrix{{2, 2}, {2, 4}, {3, 4.05}, {4, 4}, {4, 2}, {2, 2}}
+	island := matrix.LineMatrix{{3, 4.05}, {2, 4}, {2, 2}, {4, 2}, {4, 4}, {3, 4.05}}
+
+	coverage := []matrix.PolygonMatrix{{left, hole}, {right}, {island}}
+	got := CoverageSimplify(coverage, 1)
+	want := []matrix.PolygonMatrix{
+		{{{10, 0}, {10, 10}, {0, 10}, {0, 0}, {10, 0}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}},
+		{{{10, 10}, {10, 0}, {20, 0}, {20, 10}, {10, 10}}},
+		{{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}},
+	}
+	area := 0.0
+	for i, poly := range got {
+		for k, ring := range poly {
+			if !matrix.LineMatrix(ring).Equals(matrix.LineMatrix(want[i][k])) {
+				t.Errorf("CoverageSimplify() polygon %v ring %v = %v, want %v", i, k, ring, want[i][k])
+			}
+			area -= measure.AreaDirection(ring)
+		}
+	}
+	// the holes are clockwise, the area of the coverage is kept without gaps or overlaps
+	if math.Abs(math.Abs(area)-200) > 1e-9 {
+		t.Errorf("CoverageSimplify() area = %v, want 200", area)
+	}
+
+	// the triangles of a split square are rings of two edges, which keep three vertices
+	triangles := []matrix.PolygonMatrix{
+		{{{0, 0}, {10, 0}, {10, 10}, {0, 0}}},
+		{{{0, 0}, {10, 10}, {0, 10}, {0, 0}}},
+	}
+	for i, poly := range CoverageSimplify(triangles, 1000) {
+		if !matrix.LineMatrix(poly[0]).Equals(matrix.LineMatrix(triangles[i][0])) {
+			t.Errorf("CoverageSimplify() triangle %v = %v, want %v", i, poly[0], triangles[i][0])
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package simplify
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Chaikin Smooths the lines and the rings of a geometry by Chaikin corner cutting,
+// each iteration replaces every vertex by two points at a quarter and three quarters of its segments.
+// The end points of open lines are kept, rings stay closed. Points are returned unchanged.
+func Chaikin(geom matrix.Steric, iterations int) matrix.Steric {
+	return smooth(geom, func(line matrix.LineMatrix, ring bool) matrix.LineMatrix {
+		for i := 0; i < iterations; i++ {
+			line = chaikinLine(line, ring)
+		}
+		if ring {
+			line = append(line, line[0])
+		}
+		return line
+	})
+}
+
+// CatmullRom Smooths the lines and the rings of a geometry by Catmull-Rom interpolation,
+// each segment is replaced by the cubic Bezier curve through its ends, with tangents given by the neighbor vertices,
+// sampled at the number of segments, not less than one.
+// The curve passes through all the vertices, the end points of open lines are kept, rings stay closed.
+// Points are returned unchanged.
+func CatmullRom(geom matrix.Steric, segments int) matrix.Steric {
+	if segments < 1 {
+		segments = 1
+	}
+	return smooth(geom, func(line matrix.LineMatrix, ring bool) matrix.LineMatrix {
+		return catmullRomLine(line, ring, segments)
+	})
+}
+
+// smooth applies the smoothing of a line to the lines and rings of the geometry,
+// a ring is given to smoothLine without its closing point and returned closed.
+func smooth(geom matrix.Steric, smoothLine func(line matrix.LineMatrix, ring bool) matrix.LineMatrix) matrix.Steric {
+	switch m := geom.(type) {
+	case matrix.LineMatrix:
+		if len(m) < 3 {
+			return m
+		}
+		return smoothLine(m, false)
+	case matrix.PolygonMatrix:
+		poly := matrix.PolygonMatrix{}
+		for _, v := range m {
+			ring := matrix.LineMatrix(v)
+			if len(ring) >= 4 && matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+				ring = smoothLine(ring[:len(ring)-1], true)
+			}
+			poly = append(poly, ring)
+		}
+		return poly
+	case matrix.MultiPolygonMatrix:
+		multi := matrix.MultiPolygonMatrix{}
+		for _, v := range m {
+			multi = append(multi, smooth(matrix.PolygonMatrix(v), smoothLine).(matrix.PolygonMatrix))
+		}
+		return multi
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range m {
+			coll = append(coll, smooth(v, smoothLine))
+		}
+		return coll
+	}
+	return geom
+}
+
+// chaikinLine cuts the corners of the line once, a ring is given and returned without its closing point.
+func chaikinLine(line matrix.LineMatrix, ring bool) matrix.LineMatrix {
+	n := len(line)
+	if ring {
+		line = append(line[:n:n], line[0])
+		n++
+	}
+	result := matrix.LineMatrix{}
+	if !ring {
+		result = append(result, line[0])
+	}
+	for i := 0; i < n-1; i++ {
+		p, q := line[i], line[i+1]
+		if ring || i > 0 {
+			result = append(result, []float64{0.75*p[0] + 0.25*q[0], 0.75*p[1] + 0.25*q[1]})
+		}
+		if ring || i < n-2 {
+			result = append(result, []float64{0.25*p[0] + 0.75*q[0], 0.25*p[1] + 0.75*q[1]})
+		}
+	}
+	if ring {
+		return result
+	}
+	return append(result, line[n-1])
+}
+
+// catmullRomLine interpolates the line, a ring is given without its closing point and returned closed.
+func catmullRomLine(line matrix.LineMatrix, ring bool, segments int) matrix.LineMatrix {
+	n := len(line)
+	at := func(i int) []float64 {
+		switch {
+		case ring:
+			return line[(i+n)%n]
+		case i < 0:
+			return line[0]
+		case i >= n:
+			return line[n-1]
+		}
+		return line[i]
+	}
+	count := n - 1
+	if ring {
+		count = n
+	}
+	result := matrix.LineMatrix{}
+	for i := 0; i < count; i++ {
+		p0, p1, p2, p3 := at(i-1), at(i), at(i+1), at(i+2)
+		// the Bezier control points of the Catmull-Rom segment from p1 to p2
+		c1 := []float64{p1[0] + (p2[0]-p0[0])/6, p1[1] + (p2[1]-p0[1])/6}
+		c2 := []float64{p2[0] - (p3[0]-p1[0])/6, p2[1] - (p3[1]-p1[1])/6}
+		for k := 0; k < segments; k++ {
+			result = append(result, bezier(p1, c1, c2, p2, float64(k)/float64(segments)))
+		}
+	}
+	return append(result, at(count))
+}
+
+// bezier returns the point of the cubic Bezier curve at t.
+func bezier(p0, p1, p2, p3 []float64, t float64) []float64 {
+	s := 1 - t
+	a, b, c, d := s*s*s, 3*s*s*t, 3*s*t*t, t*t*t
+	return []float64{a*p0[0] + b*p1[0] + c*p2[0] + d*p3[0], a*p0[1] + b*p1[1] + c*p2[1] + d*p3[1]}
+}
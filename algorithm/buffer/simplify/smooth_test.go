@@ -0,0 +1,64 @@
+package simplify
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestChaikin(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name       string
+		geom       matrix.Steric
+		iterations int
+		want       matrix.Steric
+	}{
+		{name: "line", geom: matrix.LineMatrix{{0, 0}, {1, 1}, {2, 0}}, iterations: 1,
+			want: matrix.LineMatrix{{0, 0}, {0.75, 0.75}, {1.25, 0.75}, {2, 0}}},
+		{name: "segment", geom: matrix.LineMatrix{{0, 0}, {1, 1}}, iterations: 3,
+			want: matrix.LineMatrix{{0, 0}, {1, 1}}},
+		{name: "polygon", geom: square, iterations: 1, want: matrix.PolygonMatrix{{
+			{2.5, 0}, {7.5, 0}, {10, 2.5}, {10, 7.5}, {7.5, 10}, {2.5, 10}, {0, 7.5}, {0, 2.5}, {2.5, 0},
+		}}},
+		{name: "collection", geom: matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {1, 1}, {2, 0}}}, iterations: 1,
+			want: matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {0.75, 0.75}, {1.25, 0.75}, {2, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Chaikin(tt.geom, tt.iterations); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chaikin() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	got := Chaikin(square, 3).(matrix.PolygonMatrix)
+	if len(got[0]) != 4*8+1 || !matrix.Matrix(got[0][0]).Equals(matrix.Matrix(got[0][len(got[0])-1])) {
+		t.Errorf("Chaikin() = %v, want a closed ring of 33 points", got)
+	}
+}
+
+func TestCatmullRom(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {1, 1}, {2, 0}}
+	got := CatmullRom(line, 4).(matrix.LineMatrix)
+	if len(got) != 9 {
+		t.Fatalf("CatmullRom() = %v, want 9 points", got)
+	}
+	for i, want := range []matrix.Matrix{{0, 0}, {0.4375, 0.5625}, {1, 1}, {2, 0}} {
+		if k := []int{0, 2, 4, 8}[i]; !matrix.Matrix(got[k]).EqualsExact(want, 1e-12) {
+			t.Errorf("CatmullRom() point %v = %v, want %v", k, got[k], want)
+		}
+	}
+
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	ring := CatmullRom(square, 5).(matrix.PolygonMatrix)[0]
+	if len(ring) != 4*5+1 || !reflect.DeepEqual(ring[0], ring[len(ring)-1]) {
+		t.Errorf("CatmullRom() = %v, want a closed ring of 21 points", ring)
+	}
+	for i, v := range square[0] {
+		if !reflect.DeepEqual(ring[5*i], v) {
+			t.Errorf("CatmullRom() point %v = %v, want the vertex %v", 5*i, ring[5*i], v)
+		}
+	}
+}
@@ -0,0 +1,382 @@
+package simplify
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// VisvalingamWhyatt Simplifies a geometry using the Visvalingam-Whyatt algorithm,
+// the vertex with the smallest effective area, the area of the triangle it forms with its neighbors,
+// is removed until every vertex left has an effective area not less than the tolerance.
+// See VisvalingamWhyattCount for the vertices which are kept.
+func VisvalingamWhyatt(geom matrix.Steric, areaTolerance float64) matrix.Steric {
+	vw := newVWSimplifier(geom)
+	vw.simplify(func(area float64) bool { return area >= areaTolerance })
+	return vw.result(geom)
+}
+
+// VisvalingamWhyattCount Simplifies a geometry using the Visvalingam-Whyatt algorithm,
+// the vertices with the smallest effective area are removed until the geometry has at most count vertices.
+// The ends of the lines and three vertices of each ring are always kept,
+// and a vertex of a polygon is not removed while another vertex of the polygons lies in its triangle,
+// so valid polygons stay valid, more than count vertices are left only if no other vertex can be removed.
+// Points are returned unchanged.
+func VisvalingamWhyattCount(geom matrix.Steric, count int) matrix.Steric {
+	vw := newVWSimplifier(geom)
+	vw.simplify(func(float64) bool { return vw.count <= count })
+	return vw.result(geom)
+}
+
+// vwVertex a vertex of a line or a ring, linked to its neighbors.
+type vwVertex struct {
+	pt matrix.Matrix
+	// prev and next are the neighbors, -1 at the ends of a line.
+	prev, next int
+	part       int
+	area       float64
+	removed    bool
+	// queued is the index of the vertex in the queue, -1 if it is not queued.
+	queued int
+}
+
+// vwPart a line or a ring without its closing point.
+type vwPart struct {
+	first, size int
+	ring        bool
+	// min is the number of vertices the part keeps.
+	min int
+	// protected parts keep the vertices of the other protected parts out of the triangles of their removed vertices.
+	protected bool
+	// rings are the indexes of the ring sizes of the rings the part is an edge of.
+	rings []int
+}
+
+type vwSimplifier struct {
+	vertices []vwVertex
+	parts    []vwPart
+	queue    *vwQueue
+	// grid holds the vertices of the protected parts, which must not fall in the triangle of a removed vertex.
+	grid *vertexGrid
+	// count is the number of vertices of the result.
+	count int
+	// ringSizes are the numbers of distinct vertices of the rings made of several parts, which keep three.
+	ringSizes []int
+	// blockedBy are the vertices blocked by each vertex, queued again when it is removed.
+	blockedBy map[int][]int
+}
+
+func newVWSimplifier(geom matrix.Steric) *vwSimplifier {
+	vw := &vwSimplifier{}
+	vw.add(geom, false)
+	vw.init()
+	return vw
+}
+
+// init indexes the vertices of the protected parts and queues the removable vertices.
+func (vw *vwSimplifier) init() {
+	vw.queue = &vwQueue{vertices: &vw.vertices}
+	vw.blockedBy = map[int][]int{}
+	protected := []int{}
+	for i, v := range vw.vertices {
+		if vw.parts[v.part].protected {
+			protected = append(protected, i)
+		}
+	}
+	vw.grid = newVertexGrid(vw.vertices, protected)
+	for i := range vw.vertices {
+		if vw.removable(i) {
+			vw.vertices[i].area = vw.triangleArea(i)
+			heap.Push(vw.queue, i)
+		}
+	}
+}
+
+// add adds the lines and the rings of the geometry as parts, in the order result rebuilds them.
+func (vw *vwSimplifier) add(geom matrix.Steric, ring bool) {
+	switch m := geom.(type) {
+	case matrix.Matrix:
+		vw.count++
+	case matrix.LineMatrix:
+		closed := ring && len(m) > 3 && matrix.Matrix(m[0]).Equals(matrix.Matrix(m[len(m)-1]))
+		if closed {
+			vw.addPart(m, true, 3, true)
+		} else {
+			vw.addPart(m, false, 2, false)
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range m {
+			vw.add(matrix.LineMatrix(v), true)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			vw.add(matrix.PolygonMatrix(v), true)
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			vw.add(v, false)
+		}
+	}
+}
+
+// addPart adds a line, or a closed ring without its closing point, keeping at least min vertices.
+func (vw *vwSimplifier) addPart(line matrix.LineMatrix, ring bool, min int, protected bool) {
+	vw.count += len(line)
+	pts := line
+	if ring {
+		pts = line[:len(line)-1]
+	}
+	first := len(vw.vertices)
+	for i, v := range pts {
+		vertex := vwVertex{pt: v, prev: first + i - 1, next: first + i + 1, part: len(vw.parts), queued: -1}
+		if i == 0 {
+			vertex.prev = -1
+		}
+		if i == len(pts)-1 {
+			vertex.next = -1
+		}
+		vw.vertices = append(vw.vertices, vertex)
+	}
+	if ring {
+		vw.vertices[first].prev = len(vw.vertices) - 1
+		vw.vertices[len(vw.vertices)-1].next = first
+	}
+	vw.parts = append(vw.parts, vwPart{first: first, size: len(pts), ring: ring, min: min, protected: protected})
+}
+
+// removable returns true if the vertex is not an end of a line, and its part and its rings keep enough vertices.
+func (vw *vwSimplifier) removable(i int) bool {
+	v := vw.vertices[i]
+	part := vw.parts[v.part]
+	if v.removed || v.prev == -1 || v.next == -1 || part.size <= part.min {
+		return false
+	}
+	for _, r := range part.rings {
+		if vw.ringSizes[r] <= 3 {
+			return false
+		}
+	}
+	return true
+}
+
+func (vw *vwSimplifier) triangleArea(i int) float64 {
+	v := vw.vertices[i]
+	a, b, c := vw.vertices[v.prev].pt, v.pt, vw.vertices[v.next].pt
+	return math.Abs((b[0]-a[0])*(c[1]-a[1])-(c[0]-a[0])*(b[1]-a[1])) / 2
+}
+
+// simplify removes the vertex of smallest effective area until stop returns true for it.
+func (vw *vwSimplifier) simplify(stop func(area float64) bool) {
+	for vw.queue.Len() > 0 {
+		i := vw.queue.peek()
+		if stop(vw.vertices[i].area) {
+			return
+		}
+		heap.Pop(vw.queue)
+		if !vw.removable(i) {
+			// the vertex is queued again when one of its neighbors is removed
+			continue
+		}
+		if vw.parts[vw.vertices[i].part].protected {
+			if k := vw.blocker(i); k != -1 {
+				// the vertex is queued again when the vertex blocking it or one of its neighbors is removed
+				vw.blockedBy[k] = append(vw.blockedBy[k], i)
+				continue
+			}
+		}
+		v := &vw.vertices[i]
+		v.removed = true
+		vw.vertices[v.prev].next = v.next
+		vw.vertices[v.next].prev = v.prev
+		vw.parts[v.part].size--
+		for _, r := range vw.parts[v.part].rings {
+			vw.ringSizes[r]--
+		}
+		if vw.parts[v.part].first == i {
+			vw.parts[v.part].first = v.next
+		}
+		vw.grid.remove(i)
+		vw.count--
+		for _, n := range []int{v.prev, v.next} {
+			if !vw.removable(n) {
+				continue
+			}
+			// the effective area never decreases, so a vertex is not removed before the one it replaced
+			vw.vertices[n].area = math.Max(vw.triangleArea(n), v.area)
+			if vw.vertices[n].queued == -1 {
+				heap.Push(vw.queue, n)
+			} else {
+				heap.Fix(vw.queue, vw.vertices[n].queued)
+			}
+		}
+		for _, n := range vw.blockedBy[i] {
+			if vw.vertices[n].queued == -1 && vw.removable(n) {
+				vw.vertices[n].area = math.Max(vw.vertices[n].area, v.area)
+				heap.Push(vw.queue, n)
+			}
+		}
+		delete(vw.blockedBy, i)
+	}
+}
+
+// blocker returns a vertex of a protected part, other than the neighbors, which lies in the triangle of the vertex,
+// removing it could make the parts cross, -1 if there is none.
+func (vw *vwSimplifier) blocker(i int) int {
+	v := vw.vertices[i]
+	a, b, c := vw.vertices[v.prev].pt, v.pt, vw.vertices[v.next].pt
+	for _, k := range vw.grid.query(a, b, c) {
+		p := vw.vertices[k].pt
+		if k == i || p.Equals(a) || p.Equals(c) {
+			continue
+		}
+		o1, o2, o3 := graph.Orientation(a, b, p), graph.Orientation(b, c, p), graph.Orientation(c, a, p)
+		if (o1 >= 0 && o2 >= 0 && o3 >= 0) || (o1 <= 0 && o2 <= 0 && o3 <= 0) {
+			return k
+		}
+	}
+	return -1
+}
+
+// result rebuilds the geometry from the vertices left, consuming the parts in the order of add.
+func (vw *vwSimplifier) result(geom matrix.Steric) matrix.Steric {
+	part := 0
+	var build func(geom matrix.Steric) matrix.Steric
+	build = func(geom matrix.Steric) matrix.Steric {
+		switch m := geom.(type) {
+		case matrix.LineMatrix:
+			part++
+			return vw.line(part - 1)
+		case matrix.PolygonMatrix:
+			poly := matrix.PolygonMatrix{}
+			for _, v := range m {
+				poly = append(poly, build(matrix.LineMatrix(v)).(matrix.LineMatrix))
+			}
+			return poly
+		case matrix.MultiPolygonMatrix:
+			multi := matrix.MultiPolygonMatrix{}
+			for _, v := range m {
+				multi = append(multi, build(matrix.PolygonMatrix(v)).(matrix.PolygonMatrix))
+			}
+			return multi
+		case matrix.Collection:
+			coll := matrix.Collection{}
+			for _, v := range m {
+				coll = append(coll, build(v))
+			}
+			return coll
+		}
+		return geom
+	}
+	return build(geom)
+}
+
+// line returns the vertices left of the part, a ring is closed.
+func (vw *vwSimplifier) line(part int) matrix.LineMatrix {
+	p := vw.parts[part]
+	line := matrix.LineMatrix{}
+	for i, k := 0, p.first; i < p.size; i, k = i+1, vw.vertices[k].next {
+		line = append(line, vw.vertices[k].pt)
+	}
+	if p.ring {
+		line = append(line, line[0])
+	}
+	return line
+}
+
+// vertexGrid a uniform grid of vertices, to find the vertices near a triangle.
+type vertexGrid struct {
+	minX, minY, size float64
+	cells            map[[2]int][]int
+	vertices         []vwVertex
+}
+
+func newVertexGrid(vertices []vwVertex, indexes []int) *vertexGrid {
+	g := &vertexGrid{cells: map[[2]int][]int{}, vertices: vertices, size: 1}
+	if len(indexes) == 0 {
+		return g
+	}
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, i := range indexes {
+		pt := vertices[i].pt
+		minX, minY = math.Min(minX, pt[0]), math.Min(minY, pt[1])
+		maxX, maxY = math.Max(maxX, pt[0]), math.Max(maxY, pt[1])
+	}
+	g.minX, g.minY = minX, minY
+	if extent := math.Max(maxX-minX, maxY-minY); extent > 0 {
+		g.size = extent / math.Ceil(math.Sqrt(float64(len(indexes))))
+	}
+	for _, i := range indexes {
+		cell := g.cell(vertices[i].pt)
+		g.cells[cell] = append(g.cells[cell], i)
+	}
+	return g
+}
+
+func (g *vertexGrid) cell(pt matrix.Matrix) [2]int {
+	return [2]int{int(math.Floor((pt[0] - g.minX) / g.size)), int(math.Floor((pt[1] - g.minY) / g.size))}
+}
+
+func (g *vertexGrid) remove(i int) {
+	cell := g.cell(g.vertices[i].pt)
+	items := g.cells[cell]
+	for k, v := range items {
+		if v == i {
+			items[k] = items[len(items)-1]
+			g.cells[cell] = items[:len(items)-1]
+			return
+		}
+	}
+}
+
+// query returns the vertices in the cells of the envelope of the points.
+func (g *vertexGrid) query(pts ...matrix.Matrix) []int {
+	lo, hi := g.cell(pts[0]), g.cell(pts[0])
+	for _, pt := range pts[1:] {
+		c := g.cell(pt)
+		for k := range c {
+			if c[k] < lo[k] {
+				lo[k] = c[k]
+			}
+			if c[k] > hi[k] {
+				hi[k] = c[k]
+			}
+		}
+	}
+	result := []int{}
+	for x := lo[0]; x <= hi[0]; x++ {
+		for y := lo[1]; y <= hi[1]; y++ {
+			result = append(result, g.cells[[2]int{x, y}]...)
+		}
+	}
+	return result
+}
+
+// vwQueue a priority queue of vertex indexes, the smallest effective area first.
+type vwQueue struct {
+	items    []int
+	vertices *[]vwVertex
+}
+
+func (q vwQueue) Len() int { return len(q.items) }
+func (q vwQueue) Less(i, j int) bool {
+	return (*q.vertices)[q.items[i]].area < (*q.vertices)[q.items[j]].area
+}
+func (q vwQueue) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
+	(*q.vertices)[q.items[i]].queued = i
+	(*q.vertices)[q.items[j]].queued = j
+}
+func (q *vwQueue) Push(x interface{}) {
+	(*q.vertices)[x.(int)].queued = len(q.items)
+	q.items = append(q.items, x.(int))
+}
+func (q *vwQueue) Pop() interface{} {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	(*q.vertices)[item].queued = -1
+	return item
+}
+func (q *vwQueue) peek() int { return q.items[0] }
@@ -0,0 +1,70 @@
+package simplify
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestVisvalingamWhyatt(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}, {3, 5}, {4, 0}}
+	square := matrix.PolygonMatrix{{{0, 0}, {5, 0.01}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name      string
+		geom      matrix.Steric
+		tolerance float64
+		want      matrix.Steric
+	}{
+		{name: "line", geom: line, tolerance: 1, want: matrix.LineMatrix{{0, 0}, {2, 0}, {3, 5}, {4, 0}}},
+		{name: "line all", geom: line, tolerance: 100, want: matrix.LineMatrix{{0, 0}, {4, 0}}},
+		{name: "polygon", geom: square, tolerance: 1,
+			want: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}},
+		{name: "multi polygon", geom: matrix.MultiPolygonMatrix{square}, tolerance: 1,
+			want: matrix.MultiPolygonMatrix{{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}}},
+		{name: "collection", geom: matrix.Collection{matrix.Matrix{1, 1}, line}, tolerance: 1,
+			want: matrix.Collection{matrix.Matrix{1, 1}, matrix.LineMatrix{{0, 0}, {2, 0}, {3, 5}, {4, 0}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VisvalingamWhyatt(tt.geom, tt.tolerance); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("VisvalingamWhyatt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisvalingamWhyattCount(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {1, 0.1}, {2, 0}, {3, 5}, {4, 0}}
+	if got, want := VisvalingamWhyattCount(line, 4), (matrix.LineMatrix{{0, 0}, {2, 0}, {3, 5}, {4, 0}}); !reflect.DeepEqual(got, want) {
+		t.Errorf("VisvalingamWhyattCount() = %v, want %v", got, want)
+	}
+	if got := VisvalingamWhyattCount(line, 0).(matrix.LineMatrix); len(got) != 2 {
+		t.Errorf("VisvalingamWhyattCount() = %v, want the ends of the line", got)
+	}
+
+	// a ring keeps three vertices
+	shell := matrix.LineMatrix{{0, 0}, {5, -2}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	if got := VisvalingamWhyattCount(matrix.PolygonMatrix{shell}, 0).(matrix.PolygonMatrix); len(got[0]) != 4 {
+		t.Errorf("VisvalingamWhyattCount() = %v, want a triangle", got)
+	}
+
+	// the vertex of the shell around the hole can not be removed without the hole crossing the shell
+	hole := matrix.LineMatrix{{4, -0.5}, {6, -0.5}, {5, -1}, {4, -0.5}}
+	got := VisvalingamWhyattCount(matrix.PolygonMatrix{shell, hole}, 0).(matrix.PolygonMatrix)
+	kept := false
+	for _, v := range got[0] {
+		kept = kept || matrix.Matrix(v).Equals(matrix.Matrix{5, -2})
+	}
+	if !kept || !matrix.LineMatrix(got[1]).Equals(hole) {
+		t.Errorf("VisvalingamWhyattCount() = %v, want the vertex 5 -2 and the hole kept", got)
+	}
+
+	// the vertex of the shell blocked by the vertex 5 -0.5 of the hole is removed after it
+	shell = matrix.LineMatrix{{0, 0}, {5, -1}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	hole = matrix.LineMatrix{{1, 1}, {5, -0.5}, {9, 1}, {5, 6}, {1, 1}}
+	got = VisvalingamWhyattCount(matrix.PolygonMatrix{shell, hole}, 9).(matrix.PolygonMatrix)
+	if len(got[0])+len(got[1]) != 9 {
+		t.Errorf("VisvalingamWhyattCount() = %v, want 9 vertices", got)
+	}
+}
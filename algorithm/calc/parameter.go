@@ -65,6 +65,8 @@ const (
 	MaxRingSize = 9
 	// NearnessFactor ...
 	NearnessFactor = 0.99
+	// InvertedCurveVertexFactor is the factor of the size of a ring the size of an inverted offset curve of the ring is limited to.
+	InvertedCurveVertexFactor = 4
 
 	// CAPROUND Specifies a round line buffer end cap style.
 	CAPROUND = 1
@@ -0,0 +1,58 @@
+package coverage
+
+import (
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/overlay"
+)
+
+// Union Computes the union of the polygons of a clean coverage without a general overlay,
+// the segments shared by two polygons are dissolved, and the faces of the segments left
+// which are covered by a polygon of the coverage form the union.
+// It returns the polygon, the multi polygon of the parts or an empty polygon.
+func Union(coverage []matrix.PolygonMatrix) matrix.Steric {
+	boundary := matrix.Collection{}
+	for _, s := range coverageSegments(coverage) {
+		if !s.matched {
+			boundary = append(boundary, matrix.LineMatrix{s.p, s.q})
+		}
+	}
+	envelopes := make([]*envelope.Envelope, len(coverage))
+	for i, poly := range coverage {
+		envelopes[i] = polygonEnvelope(poly)
+	}
+
+	polys := matrix.MultiPolygonMatrix{}
+	for _, face := range overlay.Polygonize(boundary).Polygons {
+		holes := []matrix.LineMatrix{}
+		for _, hole := range face[1:] {
+			holes = append(holes, hole)
+		}
+		pt := graph.InteriorPoint(face[0], holes)
+		for i, poly := range coverage {
+			if envelopes[i] != nil && envelopes[i].Covers(envelope.Matrix(pt)) && covers(poly, pt) {
+				polys = append(polys, face)
+				break
+			}
+		}
+	}
+	switch len(polys) {
+	case 0:
+		return matrix.PolygonMatrix{}
+	case 1:
+		return matrix.PolygonMatrix(polys[0])
+	}
+	return polys
+}
+
+// covers returns true if the point is in the interior or on the boundary of the polygon,
+// the interior point of a face of the union may lie on a dissolved edge.
+func covers(poly matrix.PolygonMatrix, pt matrix.Matrix) bool {
+	for _, ring := range poly {
+		if graph.OnLine(pt, ring) {
+			return true
+		}
+	}
+	return inInterior(pt, poly)
+}
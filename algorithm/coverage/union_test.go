@@ -0,0 +1,70 @@
+package coverage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestUnion(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	holed := matrix.PolygonMatrix{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+	}
+	tests := []struct {
+		name     string
+		coverage []matrix.PolygonMatrix
+		parts    int
+		holes    int
+		area     float64
+	}{
+		{name: "adjacent", coverage: []matrix.PolygonMatrix{
+			square,
+			{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+			{{{0, 10}, {10, 10}, {20, 10}, {20, 20}, {0, 20}, {0, 10}}},
+		}, parts: 1, area: 400},
+		{name: "island", coverage: []matrix.PolygonMatrix{
+			holed,
+			{{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}}},
+		}, parts: 1, area: 100},
+		{name: "hole", coverage: []matrix.PolygonMatrix{
+			holed,
+			{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+		}, parts: 1, holes: 1, area: 196},
+		{name: "duplicate", coverage: []matrix.PolygonMatrix{square, square}, parts: 1, area: 100},
+		{name: "disjoint", coverage: []matrix.PolygonMatrix{
+			square,
+			{{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}},
+		}, parts: 2, area: 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var polys []matrix.PolygonMatrix
+			switch got := Union(tt.coverage).(type) {
+			case matrix.PolygonMatrix:
+				polys = []matrix.PolygonMatrix{got}
+			case matrix.MultiPolygonMatrix:
+				for _, v := range got {
+					polys = append(polys, v)
+				}
+			}
+			if len(polys) != tt.parts {
+				t.Fatalf("Union() = %v, want %v parts", polys, tt.parts)
+			}
+			holes, area := 0, 0.0
+			for _, poly := range polys {
+				holes += len(poly) - 1
+				area += math.Abs(measure.AreaDirection(poly[0]))
+				for _, hole := range poly[1:] {
+					area -= math.Abs(measure.AreaDirection(hole))
+				}
+			}
+			if holes != tt.holes || math.Abs(area-tt.area) > 1e-9 {
+				t.Errorf("Union() = %v, want %v holes and area %v", polys, tt.holes, tt.area)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+// Package coverage provides the validation and the union of polygonal coverages,
+// sets of polygons which tile an area and meet only along shared edges with the same vertices.
+package coverage
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// Validate Checks that the polygons form a clean coverage, and returns the invalid segments of each polygon,
+// nil for a valid polygon.
+// A segment is invalid if it crosses or partly overlaps a segment of another polygon,
+// which happens where the vertices of a shared edge do not match, if it is used more than once
+// other than by two polygons on its opposite sides, as by overlapping polygons, if it lies in the interior of another polygon,
+// or if it is not shared and lies closer than the gap width to a segment of another polygon which is not shared,
+// without touching it. A gap width not greater than 0 disables the check of gaps.
+func Validate(coverage []matrix.PolygonMatrix, gapWidth float64) [][]matrix.LineMatrix {
+	segments := coverageSegments(coverage)
+	invalid := make([]bool, len(segments))
+	for i, s := range segments {
+		invalid[i] = s.duplicated
+	}
+
+	// the segments are swept in x, a segment is only compared with the segments overlapping it in x
+	order := make([]int, len(segments))
+	for i := range order {
+		order[i] = i
+	}
+	minX := func(i int) float64 { return math.Min(segments[i].p[0], segments[i].q[0]) }
+	maxX := func(i int) float64 { return math.Max(segments[i].p[0], segments[i].q[0]) }
+	sort.Slice(order, func(a, b int) bool { return minX(order[a]) < minX(order[b]) })
+	width := math.Max(gapWidth, 0)
+	for k, i := range order {
+		for _, j := range order[k+1:] {
+			if minX(j) > maxX(i)+width {
+				break
+			}
+			s, t := segments[i], segments[j]
+			if s.polygon == t.polygon || s.key == t.key {
+				continue
+			}
+			if crossesOrOverlaps(s, t) || (gapWidth > 0 && !s.matched && !t.matched && isGap(s, t, gapWidth)) {
+				invalid[i], invalid[j] = true, true
+			}
+		}
+	}
+
+	envelopes := make([]*envelope.Envelope, len(coverage))
+	for i, poly := range coverage {
+		envelopes[i] = polygonEnvelope(poly)
+	}
+	for i, s := range segments {
+		if invalid[i] || s.matched {
+			continue
+		}
+		mid := matrix.Matrix{(s.p[0] + s.q[0]) / 2, (s.p[1] + s.q[1]) / 2}
+		for j, poly := range coverage {
+			if j != s.polygon && envelopes[j] != nil && envelopes[j].Covers(envelope.Matrix(mid)) && inInterior(mid, poly) {
+				invalid[i] = true
+				break
+			}
+		}
+	}
+
+	result := make([][]matrix.LineMatrix, len(coverage))
+	for i, s := range segments {
+		if invalid[i] {
+			result[s.polygon] = append(result[s.polygon], matrix.LineMatrix{s.p, s.q})
+		}
+	}
+	return result
+}
+
+// IsValid returns true if the polygons form a clean coverage. See Validate.
+func IsValid(coverage []matrix.PolygonMatrix, gapWidth float64) bool {
+	for _, v := range Validate(coverage, gapWidth) {
+		if len(v) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// segment a segment of a ring of a polygon of the coverage.
+type segment struct {
+	p, q    matrix.Matrix
+	polygon int
+	key     [4]float64
+	// forward is true if the polygon is on the left of the segment from the first to the second point of the key.
+	forward bool
+	// matched segments are shared by two polygons on their opposite sides,
+	// duplicated segments are used more than once otherwise.
+	matched, duplicated bool
+}
+
+// coverageSegments returns the segments of the rings of the polygons, without zero length segments.
+func coverageSegments(coverage []matrix.PolygonMatrix) []segment {
+	segments := []segment{}
+	uses := map[[4]float64][]int{}
+	for i, poly := range coverage {
+		for r, ring := range poly {
+			// the interior of the polygon is on the left of a counter clockwise shell and of a clockwise hole
+			left := (graph.SignedArea(ring) > 0) == (r == 0)
+			for k := 0; k < len(ring)-1; k++ {
+				p, q := matrix.Matrix(ring[k]), matrix.Matrix(ring[k+1])
+				if p.Equals(q) {
+					continue
+				}
+				key := graph.SegmentKey(p, q)
+				forward := (key[0] == p[0] && key[1] == p[1]) == left
+				uses[key] = append(uses[key], len(segments))
+				segments = append(segments, segment{p: p, q: q, polygon: i, key: key, forward: forward})
+			}
+		}
+	}
+	for _, v := range uses {
+		if len(v) < 2 {
+			continue
+		}
+		s, t := segments[v[0]], segments[v[1]]
+		if len(v) == 2 && s.polygon != t.polygon && s.forward != t.forward {
+			segments[v[0]].matched, segments[v[1]].matched = true, true
+			continue
+		}
+		for _, i := range v {
+			segments[i].duplicated = true
+		}
+	}
+	return segments
+}
+
+// crossesOrOverlaps returns true if the segments meet other than at an end of both.
+func crossesOrOverlaps(s, t segment) bool {
+	for _, p := range graph.Intersection(s.p, s.q, t.p, t.q) {
+		if !(p.Equals(s.p) || p.Equals(s.q)) || !(p.Equals(t.p) || p.Equals(t.q)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGap returns true if the segments do not touch and are closer than the gap width.
+func isGap(s, t segment, gapWidth float64) bool {
+	if len(graph.Intersection(s.p, s.q, t.p, t.q)) > 0 {
+		return false
+	}
+	dist := math.Min(
+		math.Min(measure.DistanceSegmentToPoint(s.p, t.p, t.q, measure.PlanarDistance),
+			measure.DistanceSegmentToPoint(s.q, t.p, t.q, measure.PlanarDistance)),
+		math.Min(measure.DistanceSegmentToPoint(t.p, s.p, s.q, measure.PlanarDistance),
+			measure.DistanceSegmentToPoint(t.q, s.p, s.q, measure.PlanarDistance)))
+	return dist < gapWidth
+}
+
+// inInterior returns true if the point is inside the shell and outside the holes of the polygon,
+// and not on its boundary.
+func inInterior(pt matrix.Matrix, poly matrix.PolygonMatrix) bool {
+	for _, ring := range poly {
+		if graph.OnLine(pt, ring) {
+			return false
+		}
+	}
+	if len(poly) == 0 || !relate.InPolygon(pt, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if relate.InPolygon(pt, hole) {
+			return false
+		}
+	}
+	return true
+}
+
+func polygonEnvelope(poly matrix.PolygonMatrix) *envelope.Envelope {
+	if len(poly) == 0 || len(poly[0]) == 0 {
+		return nil
+	}
+	env := envelope.Matrix(poly[0][0])
+	for _, v := range poly[0][1:] {
+		env.ExpandToIncludeMatrix(v)
+	}
+	return env
+}
@@ -0,0 +1,52 @@
+package coverage
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestValidate(t *testing.T) {
+	left := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name     string
+		right    matrix.PolygonMatrix
+		gapWidth float64
+		invalid  []int
+	}{
+		{name: "valid", right: matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+			gapWidth: 1, invalid: []int{0, 0}},
+		{name: "vertex not matched", right: matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 5}, {10, 0}}},
+			gapWidth: 1, invalid: []int{1, 2}},
+		{name: "overlap", right: matrix.PolygonMatrix{{{9, 0}, {19, 0}, {19, 10}, {9, 10}, {9, 0}}},
+			gapWidth: 1, invalid: []int{3, 3}},
+		{name: "gap", right: matrix.PolygonMatrix{{{10.5, 0}, {20, 0}, {20, 10}, {10.5, 10}, {10.5, 0}}},
+			gapWidth: 1, invalid: []int{3, 3}},
+		{name: "wide gap", right: matrix.PolygonMatrix{{{10.5, 0}, {20, 0}, {20, 10}, {10.5, 10}, {10.5, 0}}},
+			gapWidth: 0.1, invalid: []int{0, 0}},
+		{name: "duplicate", right: left, gapWidth: 0, invalid: []int{4, 4}},
+		{name: "duplicate reversed", right: matrix.PolygonMatrix{{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}}},
+			gapWidth: 0, invalid: []int{4, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coverage := []matrix.PolygonMatrix{left, tt.right}
+			got := Validate(coverage, tt.gapWidth)
+			for i, segments := range got {
+				if len(segments) != tt.invalid[i] {
+					t.Errorf("Validate() polygon %v = %v, want %v invalid segments", i, segments, tt.invalid[i])
+				}
+			}
+			if valid := tt.invalid[0]+tt.invalid[1] == 0; IsValid(coverage, tt.gapWidth) != valid {
+				t.Errorf("IsValid() = %v, want %v", !valid, valid)
+			}
+		})
+	}
+
+	// the segments of the shared edge which do not match are reported
+	right := matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 5}, {10, 0}}}
+	got := Validate([]matrix.PolygonMatrix{left, right}, 0)
+	if want := (matrix.LineMatrix{{10, 0}, {10, 10}}); len(got[0]) != 1 || !got[0][0].Equals(want) {
+		t.Errorf("Validate() = %v, want %v", got[0], want)
+	}
+}
@@ -0,0 +1,403 @@
+package graph
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// HalfEdge is a directed side of a segment of the graph, the face of the half edge is on its left.
+type HalfEdge struct {
+	From, To int
+	Face     int
+}
+
+// Face is a cycle of half edges with the face on their left.
+// A counter clockwise cycle bounds a face, a clockwise cycle is the outer boundary of a connected component.
+type Face struct {
+	Edges []int
+	Ring  matrix.LineMatrix
+	// Area is the signed area of the ring, positive for a counter clockwise ring.
+	Area float64
+	// Holes are the rings of the outer boundaries of the components inside a bounded face.
+	Holes []matrix.LineMatrix
+}
+
+// IsBounded returns true if the face is bounded by a counter clockwise ring.
+func (f *Face) IsBounded() bool {
+	return f.Area > 0
+}
+
+// PlanarGraph is a planar graph of noded segments, each segment is a pair of half edges.
+type PlanarGraph struct {
+	Nodes []matrix.Matrix
+	// Edges are the half edges, the twin of half edge e is e^1.
+	Edges []HalfEdge
+	// out are the outgoing half edges of each node, sorted counter clockwise.
+	out   [][]int
+	pos   []int
+	index map[[2]float64]int
+}
+
+// NewPlanarGraph returns the planar graph of segments, which must be noded, see NodeLines.
+func NewPlanarGraph(segments []matrix.LineMatrix) *PlanarGraph {
+	g := &PlanarGraph{index: map[[2]float64]int{}}
+	for _, seg := range segments {
+		from, to := g.node(seg[0]), g.node(seg[1])
+		g.Edges = append(g.Edges, HalfEdge{From: from, To: to, Face: -1}, HalfEdge{From: to, To: from, Face: -1})
+		g.out[from] = append(g.out[from], len(g.Edges)-2)
+		g.out[to] = append(g.out[to], len(g.Edges)-1)
+	}
+	g.pos = make([]int, len(g.Edges))
+	for _, edges := range g.out {
+		sort.Slice(edges, func(i, j int) bool {
+			return g.angle(edges[i]) < g.angle(edges[j])
+		})
+		for i, e := range edges {
+			g.pos[e] = i
+		}
+	}
+	return g
+}
+
+func (g *PlanarGraph) node(pt []float64) int {
+	key := [2]float64{pt[0], pt[1]}
+	if i, ok := g.index[key]; ok {
+		return i
+	}
+	g.index[key] = len(g.Nodes)
+	g.Nodes = append(g.Nodes, matrix.Matrix{pt[0], pt[1]})
+	g.out = append(g.out, nil)
+	return len(g.Nodes) - 1
+}
+
+func (g *PlanarGraph) angle(e int) float64 {
+	from, to := g.Nodes[g.Edges[e].From], g.Nodes[g.Edges[e].To]
+	return math.Atan2(to[1]-from[1], to[0]-from[0])
+}
+
+// Twin returns the half edge of the other side of e.
+func Twin(e int) int {
+	return e ^ 1
+}
+
+// Segment returns the segment of the half edge.
+func (g *PlanarGraph) Segment(e int) matrix.LineMatrix {
+	return matrix.LineMatrix{g.Nodes[g.Edges[e].From], g.Nodes[g.Edges[e].To]}
+}
+
+// Next returns the half edge following e around the face on its left.
+func (g *PlanarGraph) Next(e int) int {
+	return g.NextOf(e, func(int) bool { return true })
+}
+
+// NextOf returns the half edge following e around the face on its left, among the accepted half edges.
+// It is the first accepted half edge clockwise from the twin of e, -1 if there is none.
+func (g *PlanarGraph) NextOf(e int, accept func(int) bool) int {
+	twin := Twin(e)
+	out := g.out[g.Edges[e].To]
+	n := len(out)
+	for k := 1; k <= n; k++ {
+		if next := out[(g.pos[twin]-k+n)%n]; accept(next) {
+			return next
+		}
+	}
+	return -1
+}
+
+// Cycles returns the cycles of the accepted half edges, each half edge is in one cycle.
+func (g *PlanarGraph) Cycles(accept func(int) bool) [][]int {
+	visited := make([]bool, len(g.Edges))
+	cycles := [][]int{}
+	for e := range g.Edges {
+		if visited[e] || !accept(e) {
+			continue
+		}
+		cycle := []int{}
+		for next := e; next >= 0 && !visited[next]; next = g.NextOf(next, accept) {
+			visited[next] = true
+			cycle = append(cycle, next)
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles
+}
+
+// Chains returns the accepted segments merged into lines, which end at nodes without two accepted segments.
+// A segment is accepted by its even half edge.
+func (g *PlanarGraph) Chains(accept func(int) bool) []matrix.LineMatrix {
+	degree := make([]int, len(g.Nodes))
+	for e := 0; e < len(g.Edges); e += 2 {
+		if accept(e) {
+			degree[g.Edges[e].From]++
+			degree[g.Edges[e].To]++
+		}
+	}
+	visited := make([]bool, len(g.Edges)/2)
+	walk := func(e int) matrix.LineMatrix {
+		visited[e/2] = true
+		line := g.Segment(e)
+		for node := g.Edges[e].To; degree[node] == 2; {
+			next := -1
+			for _, h := range g.out[node] {
+				if accept(h&^1) && !visited[h/2] {
+					next = h
+					break
+				}
+			}
+			if next < 0 {
+				break
+			}
+			visited[next/2] = true
+			node = g.Edges[next].To
+			line = append(line, g.Nodes[node])
+		}
+		return line
+	}
+
+	chains := []matrix.LineMatrix{}
+	for _, ends := range []bool{true, false} {
+		for node, edges := range g.out {
+			if (degree[node] != 2) != ends {
+				continue
+			}
+			for _, h := range edges {
+				if accept(h&^1) && !visited[h/2] {
+					chains = append(chains, walk(h))
+				}
+			}
+		}
+	}
+	return chains
+}
+
+// Ring returns the closed ring of a cycle of half edges.
+func (g *PlanarGraph) Ring(cycle []int) matrix.LineMatrix {
+	ring := make(matrix.LineMatrix, 0, len(cycle)+1)
+	for _, e := range cycle {
+		ring = append(ring, g.Nodes[g.Edges[e].From])
+	}
+	return append(ring, g.Nodes[g.Edges[cycle[0]].From])
+}
+
+// Faces returns the faces of the graph, and sets the face of every half edge.
+// The outer boundary of a component is a hole of the smallest bounded face of another component containing it,
+// the face of its half edges is that bounded face.
+func (g *PlanarGraph) Faces() []*Face {
+	faces := []*Face{}
+	for i, cycle := range g.Cycles(func(int) bool { return true }) {
+		ring := g.Ring(cycle)
+		faces = append(faces, &Face{Edges: cycle, Ring: ring, Area: SignedArea(ring)})
+		for _, e := range cycle {
+			g.Edges[e].Face = i
+		}
+	}
+
+	components := g.components()
+	for _, f := range faces {
+		if f.IsBounded() {
+			continue
+		}
+		component := components[g.Edges[f.Edges[0]].From]
+		var parent *Face
+		for _, other := range faces {
+			if !other.IsBounded() || components[g.Edges[other.Edges[0]].From] == component ||
+				(parent != nil && other.Area >= parent.Area) {
+				continue
+			}
+			if relate.InPolygon(f.Ring[0], other.Ring) {
+				parent = other
+			}
+		}
+		if parent != nil {
+			parent.Holes = append(parent.Holes, f.Ring)
+			for _, e := range f.Edges {
+				g.Edges[e].Face = g.Edges[parent.Edges[0]].Face
+			}
+		}
+	}
+	return faces
+}
+
+// components returns the connected component of every node.
+func (g *PlanarGraph) components() []int {
+	nodes := NewUnionFind(len(g.Nodes))
+	for e := 0; e < len(g.Edges); e += 2 {
+		nodes.Union(g.Edges[e].From, g.Edges[e].To)
+	}
+	components := make([]int, len(g.Nodes))
+	for i := range components {
+		components[i] = nodes.Find(i)
+	}
+	return components
+}
+
+// Polygons returns the polygons bounded by the boundary half edges, which have the polygons on their left.
+// Counter clockwise rings are shells and a clockwise ring is a hole of the smallest shell containing it.
+func (g *PlanarGraph) Polygons(boundary func(int) bool) []matrix.PolygonMatrix {
+	shells, holes := []matrix.LineMatrix{}, []matrix.LineMatrix{}
+	for _, cycle := range g.Cycles(boundary) {
+		// a cycle passing a node twice bounds a shell touching itself or a hole, it is split at the node.
+		for _, ring := range SimpleRings(g.Ring(cycle)) {
+			if SignedArea(ring) > 0 {
+				shells = append(shells, ring)
+			} else {
+				holes = append(holes, ring)
+			}
+		}
+	}
+
+	polys := make([]matrix.PolygonMatrix, len(shells))
+	for i, shell := range shells {
+		polys[i] = matrix.PolygonMatrix{shell}
+	}
+	for _, hole := range holes {
+		mid := matrix.Matrix{(hole[0][0] + hole[1][0]) / 2, (hole[0][1] + hole[1][1]) / 2}
+		parent, area := -1, math.Inf(1)
+		for i, shell := range shells {
+			if a := SignedArea(shell); a < area && relate.InPolygon(mid, shell) {
+				parent, area = i, a
+			}
+		}
+		if parent >= 0 {
+			polys[parent] = append(polys[parent], hole)
+		}
+	}
+	return polys
+}
+
+// SimpleRings splits the ring into rings without repeated vertices where it passes a vertex twice,
+// rings of less than 3 distinct vertices are dropped.
+func SimpleRings(ring matrix.LineMatrix) []matrix.LineMatrix {
+	rings := []matrix.LineMatrix{}
+	stack := matrix.LineMatrix{}
+	at := map[[2]float64]int{}
+	for _, v := range ring[:len(ring)-1] {
+		key := [2]float64{v[0], v[1]}
+		if i, ok := at[key]; ok {
+			rings = append(rings, append(append(matrix.LineMatrix{}, stack[i:]...), v))
+			for _, w := range stack[i+1:] {
+				delete(at, [2]float64{w[0], w[1]})
+			}
+			stack = stack[:i+1]
+			continue
+		}
+		at[key] = len(stack)
+		stack = append(stack, v)
+	}
+	rings = append(rings, append(stack, stack[0]))
+	simple := rings[:0]
+	for _, v := range rings {
+		if len(v) > 3 {
+			simple = append(simple, v)
+		}
+	}
+	return simple
+}
+
+// SignedArea returns the signed area of the ring, positive for a counter clockwise ring.
+func SignedArea(ring matrix.LineMatrix) float64 {
+	area := 0.0
+	for i := 0; i < len(ring)-1; i++ {
+		area += ring[i][0]*ring[i+1][1] - ring[i+1][0]*ring[i][1]
+	}
+	return area / 2
+}
+
+// InteriorPoint returns a point in the interior of the area of the shell without the holes.
+// It is the middle of the widest interior interval of a horizontal line between two vertices.
+func InteriorPoint(shell matrix.LineMatrix, holes []matrix.LineMatrix) matrix.Matrix {
+	rings := append([]matrix.LineMatrix{shell}, holes...)
+
+	ys := []float64{}
+	for _, v := range shell {
+		ys = append(ys, v[1])
+	}
+	sort.Float64s(ys)
+	y, gap := ys[0], -1.0
+	for i := 0; i < len(ys)-1; i++ {
+		if ys[i+1]-ys[i] > gap {
+			y, gap = (ys[i]+ys[i+1])/2, ys[i+1]-ys[i]
+		}
+	}
+
+	xs := []float64{}
+	for _, ring := range rings {
+		for i := 0; i < len(ring)-1; i++ {
+			p, q := ring[i], ring[i+1]
+			if (p[1] < y) != (q[1] < y) {
+				xs = append(xs, p[0]+(y-p[1])*(q[0]-p[0])/(q[1]-p[1]))
+			}
+		}
+	}
+	sort.Float64s(xs)
+	x, width := shell[0][0], -1.0
+	for i := 0; i+1 < len(xs); i += 2 {
+		if xs[i+1]-xs[i] > width {
+			x, width = (xs[i]+xs[i+1])/2, xs[i+1]-xs[i]
+		}
+	}
+	return matrix.Matrix{x, y}
+}
+
+// UnionFind is a disjoint set of nodes.
+type UnionFind []int
+
+// NewUnionFind returns the disjoint set of n nodes, each in a set of its own.
+func NewUnionFind(n int) *UnionFind {
+	u := make(UnionFind, n)
+	for i := range u {
+		u[i] = i
+	}
+	return &u
+}
+
+// Add adds a node in a set of its own and returns it.
+func (u *UnionFind) Add() int {
+	*u = append(*u, len(*u))
+	return len(*u) - 1
+}
+
+// Find returns the representative node of the set of the node i.
+func (u *UnionFind) Find(i int) int {
+	for (*u)[i] != i {
+		(*u)[i] = (*u)[(*u)[i]]
+		i = (*u)[i]
+	}
+	return i
+}
+
+// Union merges the sets of the nodes i and j, it returns false if they are already in the same set.
+func (u *UnionFind) Union(i, j int) bool {
+	ri, rj := u.Find(i), u.Find(j)
+	if ri == rj {
+		return false
+	}
+	(*u)[ri] = rj
+	return true
+}
+
+// InsidePolygons returns true if the point is inside the shell and outside the holes of any of the polygons.
+func InsidePolygons(pt matrix.Matrix, polys []matrix.PolygonMatrix) bool {
+	for _, poly := range polys {
+		if InsidePolygon(pt, poly) {
+			return true
+		}
+	}
+	return false
+}
+
+// InsidePolygon returns true if the point is inside the shell and outside the holes of the polygon.
+func InsidePolygon(pt matrix.Matrix, poly matrix.PolygonMatrix) bool {
+	if len(poly) == 0 || !relate.InPolygon(pt, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if relate.InPolygon(pt, hole) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+func TestPlanarGraph_Faces(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}
+	diagonal := matrix.LineMatrix{{0, 0}, {4, 4}}
+	island := matrix.LineMatrix{{1, 2}, {2, 3}, {1, 3}, {1, 2}}
+	g := NewPlanarGraph(NodeLines([]matrix.LineMatrix{square, diagonal, island}))
+	faces := g.Faces()
+
+	bounded, area := 0, 0.0
+	for _, f := range faces {
+		if f.IsBounded() {
+			bounded++
+			area += f.Area
+			pt := InteriorPoint(f.Ring, f.Holes)
+			if !relate.InPolygon(pt, f.Ring) {
+				t.Errorf("InteriorPoint() = %v not in %v", pt, f.Ring)
+			}
+			for _, hole := range f.Holes {
+				if relate.InPolygon(pt, hole) {
+					t.Errorf("InteriorPoint() = %v in hole %v", pt, hole)
+				}
+			}
+		}
+	}
+	if bounded != 3 || area != 16.5 {
+		t.Errorf("Faces() bounded = %v area = %v, want 3 and 16.5", bounded, area)
+	}
+
+	chains := g.Chains(func(e int) bool { return g.Edges[e].Face == g.Edges[Twin(e)].Face })
+	if len(chains) != 0 {
+		t.Errorf("Chains() = %v, want no dangles", chains)
+	}
+	if chains := g.Chains(func(int) bool { return true }); len(chains) != 4 {
+		t.Errorf("Chains() = %v, want 4 lines", chains)
+	}
+}
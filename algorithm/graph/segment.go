@@ -0,0 +1,290 @@
+// Package graph provides a planar graph of noded linework, to find the faces, dangles and cut edges of the linework.
+package graph
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Orientation returns the orientation of q to the segment p1p2, 1 left, -1 right and 0 collinear.
+func Orientation(p1, p2, q matrix.Matrix) int {
+	cross := (p2[0]-p1[0])*(q[1]-p1[1]) - (p2[1]-p1[1])*(q[0]-p1[0])
+	switch {
+	case cross > 0:
+		return 1
+	case cross < 0:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// Intersection returns the intersection of the segments a0a1 and b0b1,
+// no point, the point they intersect at, or the two endpoints of their collinear overlap.
+func Intersection(a0, a1, b0, b1 matrix.Matrix) []matrix.Matrix {
+	if !boundsIntersect(a0, a1, b0, b1) {
+		return nil
+	}
+	o1, o2 := Orientation(a0, a1, b0), Orientation(a0, a1, b1)
+	o3, o4 := Orientation(b0, b1, a0), Orientation(b0, b1, a1)
+
+	if o1 == 0 && o2 == 0 {
+		ips := []matrix.Matrix{}
+		for _, v := range []struct{ pt, p, q matrix.Matrix }{{a0, b0, b1}, {a1, b0, b1}, {b0, a0, a1}, {b1, a0, a1}} {
+			if inBound(v.pt, v.p, v.q) && !ContainsPoint(ips, v.pt) {
+				ips = append(ips, matrix.Matrix{v.pt[0], v.pt[1]})
+			}
+		}
+		return ips
+	}
+	if o1*o2 > 0 || o3*o4 > 0 {
+		return nil
+	}
+	switch {
+	case o1 == 0:
+		return []matrix.Matrix{{b0[0], b0[1]}}
+	case o2 == 0:
+		return []matrix.Matrix{{b1[0], b1[1]}}
+	case o3 == 0:
+		return []matrix.Matrix{{a0[0], a0[1]}}
+	case o4 == 0:
+		return []matrix.Matrix{{a1[0], a1[1]}}
+	}
+
+	dax, day := a1[0]-a0[0], a1[1]-a0[1]
+	dbx, dby := b1[0]-b0[0], b1[1]-b0[1]
+	t := ((b0[0]-a0[0])*dby - (b0[1]-a0[1])*dbx) / (dax*dby - day*dbx)
+	return []matrix.Matrix{{a0[0] + t*dax, a0[1] + t*day}}
+}
+
+// NodeLines returns the segments of the lines split at all their intersections, without duplicates.
+// A segment is a line of two points, zero length segments are dropped.
+func NodeLines(lines []matrix.LineMatrix) []matrix.LineMatrix {
+	result := []matrix.LineMatrix{}
+	seen := map[[4]float64]bool{}
+	for _, segments := range nodeSegments(lines) {
+		for _, sub := range segments {
+			key := SegmentKey(sub[0], sub[1])
+			if !seen[key] {
+				seen[key] = true
+				result = append(result, sub)
+			}
+		}
+	}
+	return result
+}
+
+// Node returns the lines split at all their intersections with each other and with themselves,
+// in the direction of the input lines, without duplicate segments.
+// A noded line ends at the ends of the input lines and at the nodes where other than two segments meet,
+// a segment shared by several lines is kept in the first of them.
+func Node(lines []matrix.LineMatrix) []matrix.LineMatrix {
+	noded := nodeSegments(lines)
+	degree := map[[2]float64]int{}
+	seen := map[[4]float64]bool{}
+	for _, segments := range noded {
+		for _, sub := range segments {
+			if key := SegmentKey(sub[0], sub[1]); !seen[key] {
+				seen[key] = true
+				degree[[2]float64{sub[0][0], sub[0][1]}]++
+				degree[[2]float64{sub[1][0], sub[1][1]}]++
+			}
+		}
+	}
+	ends := map[[2]float64]bool{}
+	for _, line := range lines {
+		if len(line) > 0 {
+			ends[[2]float64{line[0][0], line[0][1]}] = true
+			ends[[2]float64{line[len(line)-1][0], line[len(line)-1][1]}] = true
+		}
+	}
+	isNode := func(pt []float64) bool {
+		key := [2]float64{pt[0], pt[1]}
+		return ends[key] || degree[key] != 2
+	}
+
+	result := []matrix.LineMatrix{}
+	emitted := map[[4]float64]bool{}
+	for _, segments := range noded {
+		var current matrix.LineMatrix
+		for _, sub := range segments {
+			key := SegmentKey(sub[0], sub[1])
+			if emitted[key] {
+				if current != nil {
+					result = append(result, current)
+				}
+				current = nil
+				continue
+			}
+			emitted[key] = true
+			if current != nil && !isNode(sub[0]) {
+				current = append(current, sub[1])
+				continue
+			}
+			if current != nil {
+				result = append(result, current)
+			}
+			current = matrix.LineMatrix{sub[0], sub[1]}
+		}
+		if current != nil {
+			result = append(result, current)
+		}
+	}
+	return result
+}
+
+// nodeSegments returns the segments of each line split at all intersections with the segments of the lines, in order.
+func nodeSegments(lines []matrix.LineMatrix) [][]matrix.LineMatrix {
+	segments := []matrix.LineMatrix{}
+	// owner is the index of the line of each segment
+	owner := []int{}
+	for k, line := range lines {
+		for i := 0; i < len(line)-1; i++ {
+			if !matrix.Matrix(line[i]).Equals(matrix.Matrix(line[i+1])) {
+				segments = append(segments, matrix.LineMatrix{line[i], line[i+1]})
+				owner = append(owner, k)
+			}
+		}
+	}
+
+	// sweep the segments by their min x, only segments overlapping in x are intersected.
+	order := make([]int, len(segments))
+	for i := range order {
+		order[i] = i
+	}
+	minX := func(i int) float64 { return math.Min(segments[i][0][0], segments[i][1][0]) }
+	maxX := func(i int) float64 { return math.Max(segments[i][0][0], segments[i][1][0]) }
+	sort.Slice(order, func(i, j int) bool { return minX(order[i]) < minX(order[j]) })
+
+	nodes := make([][]matrix.Matrix, len(segments))
+	for k, i := range order {
+		for _, j := range order[k+1:] {
+			if minX(j) > maxX(i) {
+				break
+			}
+			for _, ip := range Intersection(segments[i][0], segments[i][1], segments[j][0], segments[j][1]) {
+				nodes[i] = append(nodes[i], ip)
+				nodes[j] = append(nodes[j], ip)
+			}
+		}
+	}
+
+	result := make([][]matrix.LineMatrix, len(lines))
+	for i, seg := range segments {
+		result[owner[i]] = append(result[owner[i]], splitSegment(seg, nodes[i])...)
+	}
+	return result
+}
+
+// splitSegment splits the segment at the nodes.
+func splitSegment(seg matrix.LineMatrix, nodes []matrix.Matrix) []matrix.LineMatrix {
+	start := matrix.Matrix(seg[0])
+	sort.Slice(nodes, func(i, j int) bool {
+		return SquareDistance(start, nodes[i]) < SquareDistance(start, nodes[j])
+	})
+	result := []matrix.LineMatrix{}
+	prev := seg[0]
+	for _, v := range append(nodes, seg[1]) {
+		if matrix.Matrix(prev).Equals(v) {
+			continue
+		}
+		result = append(result, matrix.LineMatrix{prev, v})
+		prev = v
+	}
+	return result
+}
+
+// SegmentKey returns the same key for the segment pq and its reverse.
+func SegmentKey(p, q []float64) [4]float64 {
+	if p[0] > q[0] || (p[0] == q[0] && p[1] > q[1]) {
+		p, q = q, p
+	}
+	return [4]float64{p[0], p[1], q[0], q[1]}
+}
+
+// SquareDistance returns the square of the distance of the points.
+func SquareDistance(p, q matrix.Matrix) float64 {
+	dx, dy := p[0]-q[0], p[1]-q[1]
+	return dx*dx + dy*dy
+}
+
+func boundsIntersect(a0, a1, b0, b1 matrix.Matrix) bool {
+	return math.Max(a0[0], a1[0]) >= math.Min(b0[0], b1[0]) && math.Max(b0[0], b1[0]) >= math.Min(a0[0], a1[0]) &&
+		math.Max(a0[1], a1[1]) >= math.Min(b0[1], b1[1]) && math.Max(b0[1], b1[1]) >= math.Min(a0[1], a1[1])
+}
+
+func inBound(pt, p, q matrix.Matrix) bool {
+	return pt[0] >= math.Min(p[0], q[0]) && pt[0] <= math.Max(p[0], q[0]) &&
+		pt[1] >= math.Min(p[1], q[1]) && pt[1] <= math.Max(p[1], q[1])
+}
+
+// ContainsPoint returns true if the points contain a point with the x and y of pt.
+func ContainsPoint(points []matrix.Matrix, pt matrix.Matrix) bool {
+	for _, v := range points {
+		if v[0] == pt[0] && v[1] == pt[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveRepeated returns the points of the line without consecutive repeated points.
+func RemoveRepeated(line matrix.LineMatrix) matrix.LineMatrix {
+	result := make(matrix.LineMatrix, 0, len(line))
+	for _, v := range line {
+		if len(result) > 0 && matrix.Matrix(result[len(result)-1]).Equals(matrix.Matrix(v)) {
+			continue
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+// Reverse returns the points of the line in reverse order.
+func Reverse(line matrix.LineMatrix) matrix.LineMatrix {
+	result := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		result[len(line)-1-i] = v
+	}
+	return result
+}
+
+// OnSegment returns true if pt is on the segment ab,
+// within a tolerance relative to the magnitude of the coordinates for points computed by noding.
+func OnSegment(pt, a, b matrix.Matrix) bool {
+	if !inBound(pt, a, b) && !nearBound(pt, a, b) {
+		return false
+	}
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	len2 := dx*dx + dy*dy
+	if len2 == 0 {
+		return pt[0] == a[0] && pt[1] == a[1]
+	}
+	r := math.Max(0, math.Min(1, ((pt[0]-a[0])*dx+(pt[1]-a[1])*dy)/len2))
+	ex, ey := a[0]+r*dx-pt[0], a[1]+r*dy-pt[1]
+	return ex*ex+ey*ey <= tolerance(a, b)*tolerance(a, b)
+}
+
+// OnLine returns true if pt is on a segment of the line, see OnSegment.
+func OnLine(pt matrix.Matrix, line matrix.LineMatrix) bool {
+	for i := 0; i < len(line)-1; i++ {
+		if OnSegment(pt, line[i], line[i+1]) {
+			return true
+		}
+	}
+	return false
+}
+
+func nearBound(pt, a, b matrix.Matrix) bool {
+	tol := tolerance(a, b)
+	return pt[0] >= math.Min(a[0], b[0])-tol && pt[0] <= math.Max(a[0], b[0])+tol &&
+		pt[1] >= math.Min(a[1], b[1])-tol && pt[1] <= math.Max(a[1], b[1])+tol
+}
+
+// tolerance returns the tolerance of a point on the segment ab.
+func tolerance(a, b matrix.Matrix) float64 {
+	scale := math.Max(math.Max(math.Abs(a[0]), math.Abs(a[1])), math.Max(math.Abs(b[0]), math.Abs(b[1])))
+	return 1e-12 * math.Max(scale, 1)
+}
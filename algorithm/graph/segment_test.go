@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestIntersection(t *testing.T) {
+	tests := []struct {
+		name           string
+		a0, a1, b0, b1 matrix.Matrix
+		want           []matrix.Matrix
+	}{
+		{name: "cross", a0: matrix.Matrix{0, 0}, a1: matrix.Matrix{2, 2}, b0: matrix.Matrix{0, 2}, b1: matrix.Matrix{2, 0},
+			want: []matrix.Matrix{{1, 1}}},
+		{name: "touch", a0: matrix.Matrix{0, 0}, a1: matrix.Matrix{2, 0}, b0: matrix.Matrix{1, 0}, b1: matrix.Matrix{1, 1},
+			want: []matrix.Matrix{{1, 0}}},
+		{name: "overlap", a0: matrix.Matrix{0, 0}, a1: matrix.Matrix{2, 0}, b0: matrix.Matrix{1, 0}, b1: matrix.Matrix{3, 0},
+			want: []matrix.Matrix{{2, 0}, {1, 0}}},
+		{name: "disjoint", a0: matrix.Matrix{0, 0}, a1: matrix.Matrix{1, 0}, b0: matrix.Matrix{0, 1}, b1: matrix.Matrix{1, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Intersection(tt.a0, tt.a1, tt.b0, tt.b1)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Intersection() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equals(tt.want[i]) {
+					t.Errorf("Intersection() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNodeLines(t *testing.T) {
+	lines := []matrix.LineMatrix{{{0, 0}, {2, 2}}, {{0, 2}, {2, 0}}, {{0, 0}, {1, 1}}}
+	if got := NodeLines(lines); len(got) != 4 {
+		t.Errorf("NodeLines() = %v, want 4 segments", got)
+	}
+}
+
+func TestNode(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []matrix.LineMatrix
+		want  []matrix.LineMatrix
+	}{
+		{name: "crossing", lines: []matrix.LineMatrix{{{0, 0}, {1, 0}, {2, 0}, {4, 0}}, {{3, -1}, {3, 1}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}, {2, 0}, {3, 0}}, {{3, 0}, {4, 0}}, {{3, -1}, {3, 0}}, {{3, 0}, {3, 1}}}},
+		{name: "duplicate", lines: []matrix.LineMatrix{{{0, 0}, {2, 0}}, {{2, 0}, {1, 0}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}}},
+		{name: "self crossing", lines: []matrix.LineMatrix{{{0, 0}, {2, 2}, {2, 0}, {0, 2}}},
+			want: []matrix.LineMatrix{{{0, 0}, {1, 1}}, {{1, 1}, {2, 2}, {2, 0}, {1, 1}}, {{1, 1}, {0, 2}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Node(tt.lines); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Node() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -32,6 +32,8 @@ type Steric interface {
 }
 
 // Matrix is a one-dimensional matrix.
+// It holds the ordinates x, y and optionally z and m of a coordinate:
+// xy has 2 ordinates, xyz 3, xyzm 4, and xym 4 with z set to NaN.
 type Matrix []float64
 
 // LineMatrix is a two-dimensional matrix.
@@ -69,6 +71,32 @@ func (m Matrix) Bound() []Matrix {
 	return []Matrix{m, m}
 }
 
+// HasZ returns true if the matrix has a z ordinate.
+func (m Matrix) HasZ() bool {
+	return len(m) > 2 && !math.IsNaN(m[2])
+}
+
+// HasM returns true if the matrix has a m ordinate.
+func (m Matrix) HasM() bool {
+	return len(m) > 3 && !math.IsNaN(m[3])
+}
+
+// Z returns the z ordinate of the matrix, NaN if it has none.
+func (m Matrix) Z() float64 {
+	if m.HasZ() {
+		return m[2]
+	}
+	return math.NaN()
+}
+
+// M returns the m ordinate of the matrix, NaN if it has none.
+func (m Matrix) M() float64 {
+	if m.HasM() {
+		return m[3]
+	}
+	return math.NaN()
+}
+
 // Dimensions returns 0 because a line matrix is a 0d object.
 func (l LineMatrix) Dimensions() int {
 	return 1
@@ -324,7 +352,8 @@ func (m Matrix) Equals(ms Steric) bool {
 		}
 
 		for i := range mm {
-			if mm[i] != m[i] {
+			// a missing z of a xym coordinate is NaN on both sides.
+			if mm[i] != m[i] && !(math.IsNaN(mm[i]) && math.IsNaN(m[i])) {
 				return false
 			}
 		}
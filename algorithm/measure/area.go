@@ -52,10 +52,37 @@ func AreaDirection(ring matrix.LineMatrix) float64 {
 }
 
 // IsCCW * Tests if a ring is
-// oriented counter-clockwise
+// oriented counter-clockwise, the area direction of a clockwise ring is positive.
 func IsCCW(ring matrix.LineMatrix) bool {
-	if AreaDirection(ring) > 0 {
-		return true
+	return AreaDirection(ring) < 0
+}
+
+// SpheroidAreaOfPolygon returns the area of a Polygon geometry of lon/lat in degree on the sphere, return unit: square meter.
+func SpheroidAreaOfPolygon(polygon matrix.PolygonMatrix) float64 {
+	area := 0.0
+	for i, ring := range polygon {
+		if i == 0 {
+			area += SpheroidArea(ring)
+		} else {
+			area -= SpheroidArea(ring)
+		}
+	}
+	return area
+}
+
+// SpheroidArea returns the area of a Ring geometry of lon/lat in degree on the sphere, return unit: square meter.
+// It uses the approximation of Chamberlain and Duquette, which is accurate for rings much smaller than a hemisphere.
+func SpheroidArea(ring matrix.LineMatrix) float64 {
+	rLen := len(ring)
+	if rLen < 3 {
+		return 0.0
+	}
+	rad := math.Pi / 180.0
+	sum := 0.0
+	for i := 0; i < rLen-1; i++ {
+		lng0, lat0 := ring[i][0]*rad, ring[i][1]*rad
+		lng1, lat1 := ring[i+1][0]*rad, ring[i+1][1]*rad
+		sum += (lng1 - lng0) * (2 + math.Sin(lat0) + math.Sin(lat1))
 	}
-	return false
+	return math.Abs(sum * R * R / 2.0)
 }
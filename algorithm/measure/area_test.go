@@ -0,0 +1,26 @@
+package measure
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestIsCCW(t *testing.T) {
+	tests := []struct {
+		name string
+		ring matrix.LineMatrix
+		want bool
+	}{
+		{name: "counter-clockwise", ring: matrix.LineMatrix{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}, want: true},
+		{name: "clockwise", ring: matrix.LineMatrix{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}, want: false},
+		{name: "degenerate", ring: matrix.LineMatrix{{0, 0}, {1, 0}, {0, 0}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCCW(tt.ring); got != tt.want {
+				t.Errorf("IsCCW() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
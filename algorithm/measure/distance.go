@@ -27,8 +27,9 @@ func SpheroidDistance(from, to matrix.Matrix) float64 {
 	lat1 := to[1] * rad
 	lng1 := to[0] * rad
 	theta := lng1 - lng0
-	dist := math.Acos(math.Sin(lat0)*math.Sin(lat1) + math.Cos(lat0)*math.Cos(lat1)*math.Cos(theta))
-	return dist * R
+	// rounding may push the cosine of identical points slightly beyond 1.
+	cos := math.Min(1, math.Sin(lat0)*math.Sin(lat1)+math.Cos(lat0)*math.Cos(lat1)*math.Cos(theta))
+	return math.Acos(cos) * R
 }
 
 // MercatorDistance scale factor is changed along the meridians as a function of latitude
@@ -91,12 +92,12 @@ func DistanceSegmentToPoint(p, a, b matrix.Matrix, f Distance) float64 {
 
 // DistanceLineToPoint Returns Distance of p,line
 func DistanceLineToPoint(line matrix.LineMatrix, pt matrix.Matrix, f Distance) (dist float64) {
-	for i, v := range line {
-		tmpDist := 0.0
-		if i < len(line)-1 {
-			tmpDist = DistanceSegmentToPoint(pt, v, line[i+1], f)
-		}
-		if dist > tmpDist {
+	if len(line) == 1 {
+		return f(pt, line[0])
+	}
+	dist = math.MaxFloat64
+	for i := 0; i < len(line)-1; i++ {
+		if tmpDist := DistanceSegmentToPoint(pt, line[i], line[i+1], f); dist > tmpDist {
 			dist = tmpDist
 		}
 	}
@@ -0,0 +1,139 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// PlanarDistance3D returns the 3D Distance of pq. A missing z ordinate is taken as 0.
+func PlanarDistance3D(from, to matrix.Matrix) float64 {
+	dx, dy, dz := from[0]-to[0], from[1]-to[1], ordinateZ(from)-ordinateZ(to)
+	return math.Sqrt(dx*dx + dy*dy + dz*dz)
+}
+
+// DistanceSegmentToPoint3D Returns the 3D Distance of p,ab.
+func DistanceSegmentToPoint3D(p, a, b matrix.Matrix) float64 {
+	ab := vector3D(a, b)
+	len2 := dot3D(ab, ab)
+	if len2 == 0 {
+		return PlanarDistance3D(p, a)
+	}
+	r := dot3D(vector3D(a, p), ab) / len2
+	if r <= 0.0 {
+		return PlanarDistance3D(p, a)
+	}
+	if r >= 1.0 {
+		return PlanarDistance3D(p, b)
+	}
+	return PlanarDistance3D(p, pointAt3D(a, ab, r))
+}
+
+// DistanceSegmentToSegment3D Returns the 3D Distance of ab,cd.
+func DistanceSegmentToSegment3D(a, b, c, d matrix.Matrix) float64 {
+	u, v, w := vector3D(a, b), vector3D(c, d), vector3D(c, a)
+	uu, uv, vv, uw, vw := dot3D(u, u), dot3D(u, v), dot3D(v, v), dot3D(u, w), dot3D(v, w)
+	denom := uu*vv - uv*uv
+	if uu == 0 || vv == 0 || denom <= 1e-12*uu*vv {
+		// degenerate or parallel segments, the minimum is at an endpoint.
+		return math.Min(
+			math.Min(DistanceSegmentToPoint3D(a, c, d), DistanceSegmentToPoint3D(b, c, d)),
+			math.Min(DistanceSegmentToPoint3D(c, a, b), DistanceSegmentToPoint3D(d, a, b)))
+	}
+
+	// parameters of the closest points on the lines, clamped to the segments.
+	s := clamp01((uv*vw - vv*uw) / denom)
+	t := clamp01((uv*s + vw) / vv)
+	s = clamp01((uv*t - uw) / uu)
+	return PlanarDistance3D(pointAt3D(a, u, s), pointAt3D(c, v, t))
+}
+
+// OfLine3D Computes the 3D length of a linestring specified by a sequence of points.
+func OfLine3D(pts matrix.LineMatrix) float64 {
+	length := 0.0
+	for i := 0; i < len(pts)-1; i++ {
+		length += PlanarDistance3D(pts[i], pts[i+1])
+	}
+	return length
+}
+
+// Distance3D returns the minimum 3D distance between the two steric.
+// Polygons are measured by their rings.
+func Distance3D(from, to matrix.Steric) float64 {
+	dist := math.Inf(1)
+	for _, l0 := range lines3D(from, nil) {
+		for _, l1 := range lines3D(to, nil) {
+			if d := distanceLines3D(l0, l1); d < dist {
+				dist = d
+			}
+		}
+	}
+	return dist
+}
+
+// distanceLines3D returns the minimum 3D distance between two lines, a single point line is a point.
+func distanceLines3D(l0, l1 matrix.LineMatrix) float64 {
+	if len(l0) == 1 && len(l1) == 1 {
+		return PlanarDistance3D(l0[0], l1[0])
+	}
+	if len(l0) == 1 {
+		l0, l1 = l1, l0
+	}
+	dist := math.Inf(1)
+	for i := 0; i < len(l0)-1; i++ {
+		if len(l1) == 1 {
+			dist = math.Min(dist, DistanceSegmentToPoint3D(l1[0], l0[i], l0[i+1]))
+			continue
+		}
+		for j := 0; j < len(l1)-1; j++ {
+			dist = math.Min(dist, DistanceSegmentToSegment3D(l0[i], l0[i+1], l1[j], l1[j+1]))
+		}
+	}
+	return dist
+}
+
+// lines3D appends the points, lines and rings of the steric to lines.
+func lines3D(steric matrix.Steric, lines []matrix.LineMatrix) []matrix.LineMatrix {
+	switch m := steric.(type) {
+	case matrix.Matrix:
+		if !m.IsEmpty() {
+			lines = append(lines, matrix.LineMatrix{m})
+		}
+	case matrix.LineMatrix:
+		if !m.IsEmpty() {
+			lines = append(lines, m)
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range m {
+			lines = lines3D(matrix.LineMatrix(v), lines)
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			lines = lines3D(v, lines)
+		}
+	}
+	return lines
+}
+
+func ordinateZ(m matrix.Matrix) float64 {
+	if m.HasZ() {
+		return m[2]
+	}
+	return 0
+}
+
+func vector3D(from, to matrix.Matrix) [3]float64 {
+	return [3]float64{to[0] - from[0], to[1] - from[1], ordinateZ(to) - ordinateZ(from)}
+}
+
+func dot3D(u, v [3]float64) float64 {
+	return u[0]*v[0] + u[1]*v[1] + u[2]*v[2]
+}
+
+func pointAt3D(from matrix.Matrix, v [3]float64, r float64) matrix.Matrix {
+	return matrix.Matrix{from[0] + r*v[0], from[1] + r*v[1], ordinateZ(from) + r*v[2]}
+}
+
+func clamp01(v float64) float64 {
+	return math.Max(0, math.Min(1, v))
+}
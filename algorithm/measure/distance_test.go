@@ -53,3 +53,27 @@ func TestMercatorDistance(t *testing.T) {
 		})
 	}
 }
+
+func TestDistanceLineToPoint(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	tests := []struct {
+		name string
+		pt   matrix.Matrix
+		want float64
+	}{
+		{name: "first segment", pt: matrix.Matrix{5, -3}, want: 3},
+		{name: "second segment", pt: matrix.Matrix{12, 5}, want: 2},
+		{name: "vertex", pt: matrix.Matrix{13, -4}, want: 5},
+		{name: "on line", pt: matrix.Matrix{10, 5}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DistanceLineToPoint(line, tt.pt, PlanarDistance); got != tt.want {
+				t.Errorf("DistanceLineToPoint() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := DistanceLineToPoint(matrix.LineMatrix{{3, 4}}, matrix.Matrix{0, 0}, PlanarDistance); got != 5 {
+		t.Errorf("DistanceLineToPoint() = %v, want 5 for a line of one point", got)
+	}
+}
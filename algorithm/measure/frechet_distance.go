@@ -0,0 +1,97 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/algoerr"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// FrechetDistance Computes the discrete Frechet distance between the vertices of two geometries,
+// the shortest leash joining two walkers which move forward along the vertices of each geometry in their order.
+// Unlike the Hausdorff distance it depends on the direction of the lines,
+// a line and its reverse are apart by the distance between their ends.
+// It returns 0 if a geometry is empty.
+func FrechetDistance(g0, g1 matrix.Steric) float64 {
+	return discreteFrechet(frechetPoints(g0, 1), frechetPoints(g1, 1))
+}
+
+// FrechetDistanceDensify Computes the discrete Frechet distance with the segments of the geometries
+// split into equal parts of the densify fraction of their length, which must be in (0, 1],
+// to approach the continuous Frechet distance.
+func FrechetDistanceDensify(g0, g1 matrix.Steric, densifyFrac float64) (float64, error) {
+	if densifyFrac > 1.0 || densifyFrac <= 0.0 {
+		return 0, algoerr.ErrWrongFractionRange
+	}
+	numSubSegs := int(1.0 / densifyFrac)
+	return discreteFrechet(frechetPoints(g0, numSubSegs), frechetPoints(g1, numSubSegs)), nil
+}
+
+// frechetPoints returns the vertices of the geometry in order, with the segments of each part split
+// into the number of sub segments.
+func frechetPoints(geom matrix.Steric, numSubSegs int) []matrix.Matrix {
+	switch m := geom.(type) {
+	case matrix.LineMatrix:
+		return densifyPoints(matrix.TransMatrixes(m), numSubSegs)
+	case matrix.PolygonMatrix:
+		pts := []matrix.Matrix{}
+		for _, v := range m {
+			pts = append(pts, densifyPoints(matrix.TransMatrixes(matrix.LineMatrix(v)), numSubSegs)...)
+		}
+		return pts
+	case matrix.MultiPolygonMatrix:
+		pts := []matrix.Matrix{}
+		for _, v := range m {
+			pts = append(pts, frechetPoints(matrix.PolygonMatrix(v), numSubSegs)...)
+		}
+		return pts
+	case matrix.Collection:
+		pts := []matrix.Matrix{}
+		for _, v := range m {
+			pts = append(pts, frechetPoints(v, numSubSegs)...)
+		}
+		return pts
+	}
+	return matrix.TransMatrixes(geom)
+}
+
+func densifyPoints(pts []matrix.Matrix, numSubSegs int) []matrix.Matrix {
+	if numSubSegs <= 1 || len(pts) < 2 {
+		return pts
+	}
+	result := []matrix.Matrix{}
+	for i := 0; i < len(pts)-1; i++ {
+		p0, p1 := pts[i], pts[i+1]
+		for k := 0; k < numSubSegs; k++ {
+			f := float64(k) / float64(numSubSegs)
+			result = append(result, matrix.Matrix{p0[0] + f*(p1[0]-p0[0]), p0[1] + f*(p1[1]-p0[1])})
+		}
+	}
+	return append(result, pts[len(pts)-1])
+}
+
+// discreteFrechet returns the discrete Frechet distance of the point sequences,
+// the coupling distances are computed row by row, keeping two rows.
+func discreteFrechet(p, q []matrix.Matrix) float64 {
+	if len(p) == 0 || len(q) == 0 {
+		return 0
+	}
+	prev, row := make([]float64, len(q)), make([]float64, len(q))
+	for i := range p {
+		for j := range q {
+			d := math.Hypot(p[i][0]-q[j][0], p[i][1]-q[j][1])
+			switch {
+			case i == 0 && j == 0:
+				row[j] = d
+			case i == 0:
+				row[j] = math.Max(row[j-1], d)
+			case j == 0:
+				row[j] = math.Max(prev[j], d)
+			default:
+				row[j] = math.Max(math.Min(math.Min(prev[j], prev[j-1]), row[j-1]), d)
+			}
+		}
+		prev, row = row, prev
+	}
+	return prev[len(q)-1]
+}
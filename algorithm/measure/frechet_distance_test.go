@@ -0,0 +1,41 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestFrechetDistance(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {100, 0}}
+	tests := []struct {
+		name   string
+		g0, g1 matrix.Steric
+		want   float64
+	}{
+		{name: "lines", g0: line, g1: matrix.LineMatrix{{0, 0}, {50, 50}, {100, 0}}, want: 50 * math.Sqrt2},
+		{name: "reversed", g0: line, g1: matrix.LineMatrix{{100, 0}, {0, 0}}, want: 100},
+		{name: "same", g0: line, g1: line, want: 0},
+		{name: "point", g0: line, g1: matrix.Matrix{50, 10}, want: math.Hypot(50, 10)},
+		{name: "empty", g0: line, g1: matrix.LineMatrix{}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FrechetDistance(tt.g0, tt.g1); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("FrechetDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrechetDistanceDensify(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {100, 0}}
+	got, err := FrechetDistanceDensify(line, matrix.LineMatrix{{0, 0}, {50, 50}, {100, 0}}, 0.5)
+	if err != nil || math.Abs(got-50) > 1e-9 {
+		t.Errorf("FrechetDistanceDensify() = %v, %v, want 50", got, err)
+	}
+	if _, err := FrechetDistanceDensify(line, line, 0); err == nil {
+		t.Errorf("FrechetDistanceDensify() error = nil for a fraction of 0")
+	}
+}
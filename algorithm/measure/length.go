@@ -25,3 +25,12 @@ func OfLine(pts matrix.LineMatrix) float64 {
 	}
 	return 0.0
 }
+
+// SpheroidOfLine Computes the length on the sphere of a linestring of lon/lat in degree, return unit: meter.
+func SpheroidOfLine(pts matrix.LineMatrix) float64 {
+	length := 0.0
+	for i := 0; i < len(pts)-1; i++ {
+		length += SpheroidDistance(pts[i], pts[i+1])
+	}
+	return length
+}
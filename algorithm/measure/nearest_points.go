@@ -0,0 +1,119 @@
+package measure
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// NearestPoints Computes the points of two geometries which are nearest to each other,
+// the first point on g0 and the second on g1, and the distance between them measured by the distance func.
+// The point of a segment nearest to a point is found in the plane of the coordinates.
+// If the geometries meet, or a part of one lies inside a polygon of the other,
+// both points are the same point where they meet and the distance is 0.
+// It returns nil if a geometry is empty.
+func NearestPoints(g0, g1 matrix.Steric, f Distance) *PointPairDistance {
+	c0, c1 := &nearestComponents{}, &nearestComponents{}
+	c0.add(g0)
+	c1.add(g1)
+	if len(c0.segments) == 0 || len(c1.segments) == 0 {
+		return nil
+	}
+	if pt := c1.inPolygon(c0); pt != nil {
+		return &PointPairDistance{Pt: [2]matrix.Matrix{pt, pt}}
+	}
+	if pt := c0.inPolygon(c1); pt != nil {
+		return &PointPairDistance{Pt: [2]matrix.Matrix{pt, pt}}
+	}
+
+	ptDist := &PointPairDistance{IsNil: true}
+	update := func(p0, p1 matrix.Matrix) {
+		if dist := f(p0, p1); ptDist.IsNil || dist < ptDist.Distance {
+			ptDist.Pt, ptDist.Distance, ptDist.IsNil = [2]matrix.Matrix{p0, p1}, dist, false
+		}
+	}
+	for _, s0 := range c0.segments {
+		for _, s1 := range c1.segments {
+			if mark, ips := relate.Intersection(s0[0], s0[1], s1[0], s1[1]); mark && len(ips) > 0 {
+				pt := ips[0].Matrix
+				return &PointPairDistance{Pt: [2]matrix.Matrix{pt, pt}}
+			}
+			// segments which do not meet are nearest at an end of one of them.
+			update(s0[0], ClosestPoint(s0[0], s1[0], s1[1]))
+			update(s0[1], ClosestPoint(s0[1], s1[0], s1[1]))
+			update(ClosestPoint(s1[0], s0[0], s0[1]), s1[0])
+			update(ClosestPoint(s1[1], s0[0], s0[1]), s1[1])
+		}
+	}
+	return ptDist
+}
+
+// nearestComponents the segments of a geometry, a point being a segment of zero length,
+// its polygons and a vertex of each part.
+type nearestComponents struct {
+	segments [][2]matrix.Matrix
+	polygons []matrix.PolygonMatrix
+	vertices []matrix.Matrix
+}
+
+func (c *nearestComponents) add(geom matrix.Steric) {
+	switch m := geom.(type) {
+	case matrix.Matrix:
+		if len(m) >= 2 {
+			c.segments = append(c.segments, [2]matrix.Matrix{m, m})
+			c.vertices = append(c.vertices, m)
+		}
+	case matrix.LineMatrix:
+		c.addLine(m)
+	case matrix.PolygonMatrix:
+		if len(m) > 0 && len(m[0]) > 0 {
+			c.polygons = append(c.polygons, m)
+		}
+		for _, v := range m {
+			c.addLine(v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, v := range m {
+			c.add(matrix.PolygonMatrix(v))
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			c.add(v)
+		}
+	}
+}
+
+func (c *nearestComponents) addLine(line matrix.LineMatrix) {
+	switch len(line) {
+	case 0:
+		return
+	case 1:
+		c.segments = append(c.segments, [2]matrix.Matrix{line[0], line[0]})
+	}
+	for i := 0; i < len(line)-1; i++ {
+		c.segments = append(c.segments, [2]matrix.Matrix{line[i], line[i+1]})
+	}
+	c.vertices = append(c.vertices, line[0])
+}
+
+// inPolygon returns a vertex of the other components which lies inside a polygon of the components, or nil.
+// A part crossing the boundary of a polygon is found by the intersection of the segments.
+func (c *nearestComponents) inPolygon(other *nearestComponents) matrix.Matrix {
+	for _, poly := range c.polygons {
+		for _, pt := range other.vertices {
+			if !relate.InPolygon(pt, poly[0]) {
+				continue
+			}
+			inHole := false
+			for _, hole := range poly[1:] {
+				if relate.InPolygon(pt, hole) {
+					inHole = true
+					break
+				}
+			}
+			if !inHole {
+				return pt
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package measure
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestNearestPoints(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}}
+	holed := matrix.PolygonMatrix{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+	}
+	tests := []struct {
+		name   string
+		g0, g1 matrix.Steric
+		want   [2]matrix.Matrix
+		dist   float64
+	}{
+		{name: "points", g0: matrix.Matrix{0, 0}, g1: matrix.Matrix{3, 4},
+			want: [2]matrix.Matrix{{0, 0}, {3, 4}}, dist: 5},
+		{name: "point line", g0: matrix.Matrix{5, 5}, g1: line,
+			want: [2]matrix.Matrix{{5, 5}, {5, 0}}, dist: 5},
+		{name: "crossing lines", g0: matrix.LineMatrix{{5, -1}, {5, 1}}, g1: line,
+			want: [2]matrix.Matrix{{5, 0}, {5, 0}}, dist: 0},
+		{name: "apart lines", g0: line, g1: matrix.LineMatrix{{12, 1}, {20, 1}},
+			want: [2]matrix.Matrix{{10, 0}, {12, 1}}, dist: math.Sqrt(5)},
+		{name: "point in polygon", g0: holed, g1: matrix.Matrix{1, 5},
+			want: [2]matrix.Matrix{{1, 5}, {1, 5}}, dist: 0},
+		{name: "point in hole", g0: holed, g1: matrix.Matrix{4, 5},
+			want: [2]matrix.Matrix{{2, 5}, {4, 5}}, dist: 2},
+		{name: "collection", g0: matrix.Collection{matrix.Matrix{20, 20}, line}, g1: matrix.Matrix{12, 0},
+			want: [2]matrix.Matrix{{10, 0}, {12, 0}}, dist: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NearestPoints(tt.g0, tt.g1, PlanarDistance)
+			if got == nil || !got.Pt[0].Equals(tt.want[0]) || !got.Pt[1].Equals(tt.want[1]) ||
+				math.Abs(got.Distance-tt.dist) > 1e-9 {
+				t.Errorf("NearestPoints() = %v, want %v at %v", got, tt.want, tt.dist)
+			}
+		})
+	}
+	if got := NearestPoints(line, matrix.LineMatrix{}, PlanarDistance); got != nil {
+		t.Errorf("NearestPoints() = %v, want nil", got)
+	}
+}
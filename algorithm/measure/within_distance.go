@@ -0,0 +1,87 @@
+package measure
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// IsWithinDistance Returns true if the geometries are within the distance of each other in the plane.
+// The facets are compared only if their envelopes are not farther apart than the distance,
+// and the comparison stops at the first pair of facets close enough.
+// It returns false if a geometry is empty.
+func IsWithinDistance(g0, g1 matrix.Steric, distance float64) bool {
+	return isWithinDistance(g0, g1, distance, PlanarDistance, planarGap)
+}
+
+// IsWithinSpheroidDistance Returns true if the lon/lat geometries are within the distance in meters
+// of each other on the sphere of the earth, like IsWithinDistance.
+// The envelopes are compared by their difference in latitude, the distance on the sphere is never less.
+func IsWithinSpheroidDistance(g0, g1 matrix.Steric, distance float64) bool {
+	return isWithinDistance(g0, g1, distance, SpheroidDistance, latitudeGap)
+}
+
+// envelopeGap returns a distance not greater than the distance between any points of the envelopes.
+type envelopeGap func(e0, e1 *envelope.Envelope) float64
+
+func planarGap(e0, e1 *envelope.Envelope) float64 {
+	return e0.Distance(e1)
+}
+
+func latitudeGap(e0, e1 *envelope.Envelope) float64 {
+	dy := math.Max(0, math.Max(e1.MinY-e0.MaxY, e0.MinY-e1.MaxY))
+	return dy * math.Pi / 180.0 * R
+}
+
+func isWithinDistance(g0, g1 matrix.Steric, distance float64, f Distance, gap envelopeGap) bool {
+	if distance < 0 {
+		return false
+	}
+	c0, c1 := &nearestComponents{}, &nearestComponents{}
+	c0.add(g0)
+	c1.add(g1)
+	if len(c0.segments) == 0 || len(c1.segments) == 0 {
+		return false
+	}
+	env0, envs0 := segmentEnvelopes(c0.segments)
+	env1, envs1 := segmentEnvelopes(c1.segments)
+	if gap(env0, env1) > distance {
+		return false
+	}
+
+	for i, s0 := range c0.segments {
+		if gap(envs0[i], env1) > distance {
+			continue
+		}
+		for j, s1 := range c1.segments {
+			if gap(envs0[i], envs1[j]) > distance {
+				continue
+			}
+			if f(s0[0], ClosestPoint(s0[0], s1[0], s1[1])) <= distance ||
+				f(s0[1], ClosestPoint(s0[1], s1[0], s1[1])) <= distance ||
+				f(ClosestPoint(s1[0], s0[0], s0[1]), s1[0]) <= distance ||
+				f(ClosestPoint(s1[1], s0[0], s0[1]), s1[1]) <= distance {
+				return true
+			}
+			// segments crossing in their interiors are apart from each other at their ends.
+			if mark, _ := relate.Intersection(s0[0], s0[1], s1[0], s1[1]); mark {
+				return true
+			}
+		}
+	}
+	// no boundaries are close enough, a geometry may still lie inside a polygon of the other.
+	return c1.inPolygon(c0) != nil || c0.inPolygon(c1) != nil
+}
+
+// segmentEnvelopes returns the envelope of the segments and the envelope of each segment.
+func segmentEnvelopes(segments [][2]matrix.Matrix) (*envelope.Envelope, []*envelope.Envelope) {
+	envs := make([]*envelope.Envelope, len(segments))
+	env := envelope.TwoMatrix(segments[0][0], segments[0][1])
+	for i, s := range segments {
+		envs[i] = envelope.TwoMatrix(s[0], s[1])
+		env.ExpandToIncludeEnv(envs[i])
+	}
+	return env, envs
+}
@@ -0,0 +1,53 @@
+package measure
+
+import (
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestIsWithinDistance(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}}
+	holed := matrix.PolygonMatrix{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+	}
+	tests := []struct {
+		name     string
+		g0, g1   matrix.Steric
+		distance float64
+		want     bool
+	}{
+		{name: "point line", g0: matrix.Matrix{5, 5}, g1: line, distance: 5, want: true},
+		{name: "point line apart", g0: matrix.Matrix{5, 5}, g1: line, distance: 4.9, want: false},
+		{name: "crossing lines", g0: matrix.LineMatrix{{5, -1}, {5, 1}}, g1: line, distance: 0, want: true},
+		{name: "point in polygon", g0: matrix.Matrix{1, 5}, g1: holed, distance: 0, want: true},
+		{name: "point in hole", g0: matrix.Matrix{4, 5}, g1: holed, distance: 2, want: true},
+		{name: "point in hole apart", g0: matrix.Matrix{4, 5}, g1: holed, distance: 1.9, want: false},
+		{name: "far", g0: matrix.Matrix{100, 100}, g1: holed, distance: 10, want: false},
+		{name: "negative distance", g0: line, g1: line, distance: -1, want: false},
+		{name: "empty", g0: line, g1: matrix.LineMatrix{}, distance: 10, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWithinDistance(tt.g0, tt.g1, tt.distance); got != tt.want {
+				t.Errorf("IsWithinDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsWithinSpheroidDistance(t *testing.T) {
+	pt := matrix.Matrix{0, 60}
+	east, north := matrix.Matrix{10, 60}, matrix.Matrix{0, 67}
+	dist := SpheroidDistance(pt, east)
+	if !IsWithinSpheroidDistance(pt, matrix.Collection{east, north}, dist) {
+		t.Errorf("IsWithinSpheroidDistance() = false at %v", dist)
+	}
+	if IsWithinSpheroidDistance(pt, north, dist) {
+		t.Errorf("IsWithinSpheroidDistance() = true at %v, want false for %v", dist, SpheroidDistance(pt, north))
+	}
+	if IsWithinSpheroidDistance(pt, east, dist*0.99) {
+		t.Errorf("IsWithinSpheroidDistance() = true below %v", dist)
+	}
+}
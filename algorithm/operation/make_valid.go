@@ -0,0 +1,191 @@
+package operation
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// MakeValid returns a valid steric which keeps all the area of the steric.
+// The elements of a collection are repaired on their own, see MakeValidMultiPolygon.
+// Polygon rings are noded and the faces inside an odd number of rings of a polygon are kept,
+// rings which collapse to lines or points are returned with the polygons in a collection.
+func (el *ValidOP) MakeValid() matrix.Steric {
+	if el.Validate() == nil {
+		return el.Steric
+	}
+	switch matr := removeInvalidCoordinates(el.Steric).(type) {
+	case matrix.Matrix:
+		return matr
+	case matrix.LineMatrix:
+		if pts := graph.RemoveRepeated(matr); len(pts) == 1 {
+			return matrix.Matrix(pts[0])
+		}
+		return matr
+	case matrix.PolygonMatrix:
+		return makeValidPolygons([]matrix.PolygonMatrix{matr})
+	case matrix.Collection:
+		coll := matrix.Collection{}
+		for _, v := range matr {
+			elem := &ValidOP{v}
+			coll = append(coll, elem.MakeValid())
+		}
+		return coll
+	}
+	return el.Steric
+}
+
+// MakeValidMultiPolygon returns a valid multi polygon which keeps all the area of a collection of polygons.
+// Overlapping polygons are dissolved.
+func (el *ValidOP) MakeValidMultiPolygon() matrix.Steric {
+	if el.ValidateMultiPolygon() == nil {
+		return el.Steric
+	}
+	coll, ok := removeInvalidCoordinates(el.Steric).(matrix.Collection)
+	if !ok {
+		return el.MakeValid()
+	}
+	polys := []matrix.PolygonMatrix{}
+	for _, v := range coll {
+		poly, ok := v.(matrix.PolygonMatrix)
+		if !ok {
+			return el.MakeValid()
+		}
+		polys = append(polys, poly)
+	}
+	return makeValidPolygons(polys)
+}
+
+// makeValidPolygons returns the area inside an odd number of rings of any of the polygons.
+func makeValidPolygons(polys []matrix.PolygonMatrix) matrix.Steric {
+	lines := []matrix.LineMatrix{}
+	groups := make([][]matrix.LineMatrix, len(polys))
+	collapses := matrix.Collection{}
+	for i, poly := range polys {
+		for _, v := range poly {
+			ring := graph.RemoveRepeated(v)
+			if len(ring) == 0 {
+				continue
+			}
+			if len(ring) == 1 {
+				collapses = append(collapses, matrix.Matrix(ring[0]))
+				continue
+			}
+			if !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+				ring = append(ring, ring[0])
+			}
+			groups[i] = append(groups[i], ring)
+			lines = append(lines, ring)
+		}
+	}
+
+	g := graph.NewPlanarGraph(graph.NodeLines(lines))
+	faces := g.Faces()
+	inside := make([]bool, len(faces))
+	for i, f := range faces {
+		inside[i] = f.IsBounded() && insideOddRings(graph.InteriorPoint(f.Ring, f.Holes), groups)
+	}
+
+	result := matrix.Collection{}
+	for _, poly := range g.Polygons(func(e int) bool {
+		return inside[g.Edges[e].Face] && !inside[g.Edges[graph.Twin(e)].Face]
+	}) {
+		result = append(result, poly)
+	}
+
+	// edges with the same face on both sides outside the area are collapsed linework.
+	dangles := matrix.Collection{}
+	for _, line := range g.Chains(func(e int) bool {
+		face := g.Edges[e].Face
+		return face == g.Edges[graph.Twin(e)].Face && !inside[face]
+	}) {
+		dangles = append(dangles, line)
+	}
+	collapses = append(dangles, collapses...)
+	for _, v := range collapses {
+		if pt, ok := v.(matrix.Matrix); ok && coveredBy(pt, result) {
+			continue
+		}
+		result = append(result, v)
+	}
+
+	if len(result) == 1 {
+		return result[0]
+	}
+	return result
+}
+
+// insideOddRings returns true if the point is inside an odd number of the rings of any group.
+func insideOddRings(pt matrix.Matrix, groups [][]matrix.LineMatrix) bool {
+	for _, rings := range groups {
+		count := 0
+		for _, ring := range rings {
+			if relate.InPolygon(pt, ring) {
+				count++
+			}
+		}
+		if count%2 == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// coveredBy returns true if the point is on or inside any polygon of the collection.
+func coveredBy(pt matrix.Matrix, coll matrix.Collection) bool {
+	for _, v := range coll {
+		switch m := v.(type) {
+		case matrix.PolygonMatrix:
+			if inPolygonInterior(pt, m) || relate.InLineMatrix(pt, m[0]) {
+				return true
+			}
+		case matrix.LineMatrix:
+			if relate.InLineMatrix(pt, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// removeInvalidCoordinates returns a copy of the steric without the coordinates which are not finite.
+func removeInvalidCoordinates(steric matrix.Steric) matrix.Steric {
+	if validateCoordinates(steric) == nil {
+		return steric
+	}
+	valid := func(v []float64) bool {
+		return len(v) >= 2 && !math.IsNaN(v[0]) && !math.IsNaN(v[1]) && !math.IsInf(v[0], 0) && !math.IsInf(v[1], 0)
+	}
+	removeLine := func(line matrix.LineMatrix) matrix.LineMatrix {
+		result := matrix.LineMatrix{}
+		for _, v := range line {
+			if valid(v) {
+				result = append(result, v)
+			}
+		}
+		return result
+	}
+	switch matr := steric.(type) {
+	case matrix.Matrix:
+		if !valid(matr) {
+			return matrix.Collection{}
+		}
+	case matrix.LineMatrix:
+		return removeLine(matr)
+	case matrix.PolygonMatrix:
+		result := matrix.PolygonMatrix{}
+		for _, v := range matr {
+			result = append(result, removeLine(v))
+		}
+		return result
+	case matrix.Collection:
+		result := matrix.Collection{}
+		for _, v := range matr {
+			result = append(result, removeInvalidCoordinates(v))
+		}
+		return result
+	}
+	return steric
+}
@@ -0,0 +1,81 @@
+package operation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestValidOP_MakeValid(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name      string
+		steric    matrix.Steric
+		multi     bool
+		wantArea  float64
+		wantParts int
+		wantLines int
+	}{
+		{name: "bow tie", steric: matrix.PolygonMatrix{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+			wantArea: 2, wantParts: 2},
+		{name: "self touching ring", steric: matrix.PolygonMatrix{{{0, 0}, {4, 0}, {2, 2}, {3, 3}, {1, 3}, {2, 2}, {0, 0}}},
+			wantArea: 5, wantParts: 2},
+		{name: "hole outside shell", steric: matrix.PolygonMatrix{square, {{12, 2}, {12, 4}, {14, 4}, {12, 2}}},
+			wantArea: 102, wantParts: 2},
+		{name: "hole touching shell", steric: matrix.PolygonMatrix{square, {{0, 5}, {2, 6}, {2, 4}, {0, 5}}, {{12, 2}, {12, 4}, {14, 4}, {12, 2}}},
+			wantArea: 100, wantParts: 2},
+		{name: "nested holes", steric: matrix.PolygonMatrix{square, {{1, 1}, {1, 9}, {9, 9}, {9, 1}, {1, 1}}, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}},
+			wantArea: 100 - 64 + 4, wantParts: 2},
+		{name: "repeated points", steric: matrix.PolygonMatrix{{{0, 0}, {0, 0}, {10, 0}, {10, 10}, {10, 10}, {0, 10}, {0, 0}}},
+			wantArea: 100, wantParts: 1},
+		{name: "collapsed ring", steric: matrix.PolygonMatrix{{{0, 0}, {1, 1}, {0, 0}}},
+			wantLines: 1},
+		{name: "spike", steric: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {20, 10}, {10, 10}, {0, 10}, {0, 0}}},
+			wantArea: 100, wantParts: 1, wantLines: 1},
+		{name: "overlapping multipolygon", steric: matrix.Collection{matrix.PolygonMatrix{square},
+			matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}, multi: true,
+			wantArea: 175, wantParts: 1},
+		{name: "not closed", steric: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}}},
+			wantArea: 100, wantParts: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			el := &ValidOP{Steric: tt.steric}
+			got := el.MakeValid()
+			if tt.multi {
+				got = el.MakeValidMultiPolygon()
+			}
+			polys, lines := matrix.Collection{}, 0
+			area := 0.0
+			parts := []matrix.Steric{got}
+			if coll, ok := got.(matrix.Collection); ok {
+				parts = coll
+			}
+			for _, v := range parts {
+				switch m := v.(type) {
+				case matrix.PolygonMatrix:
+					polys = append(polys, m)
+					for _, ring := range m {
+						area += graph.SignedArea(ring)
+					}
+				case matrix.LineMatrix:
+					lines++
+				}
+			}
+			if math.Abs(area-tt.wantArea) > 1e-9 || len(polys) != tt.wantParts || lines != tt.wantLines {
+				t.Errorf("MakeValid() = %v, area %v polygons %v lines %v", got, area, len(polys), lines)
+			}
+			check := &ValidOP{Steric: polys}
+			if err := check.ValidateMultiPolygon(); err != nil {
+				t.Errorf("MakeValid() = %v is not valid: %v", got, err)
+			}
+		})
+	}
+
+	valid := matrix.PolygonMatrix{square}
+	if got := (&ValidOP{Steric: valid}).MakeValid(); !got.Equals(valid) {
+		t.Errorf("MakeValid() = %v, want %v", got, valid)
+	}
+}
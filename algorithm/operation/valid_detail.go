@@ -0,0 +1,358 @@
+package operation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// ValidationKind is the kind of a topology validation error, in the order of the GEOS error codes.
+type ValidationKind int
+
+// const topology validation error kinds.
+const (
+	TopologyValidationError ValidationKind = iota
+	RepeatedPoint
+	HoleOutsideShell
+	NestedHoles
+	DisconnectedInterior
+	SelfIntersection
+	RingSelfIntersection
+	NestedShells
+	DuplicateRings
+	TooFewPoints
+	InvalidCoordinate
+	RingNotClosed
+)
+
+// ValidGeometry is the reason of a valid geometry.
+const ValidGeometry = "Valid Geometry"
+
+var validationMessages = []string{
+	"Topology Validation Error",
+	"Repeated Point",
+	"Hole lies outside shell",
+	"Holes are nested",
+	"Interior is disconnected",
+	"Self-intersection",
+	"Ring Self-intersection",
+	"Nested shells",
+	"Duplicate Rings",
+	"Too few distinct points in geometry component",
+	"Invalid Coordinate",
+	"Ring is not closed",
+}
+
+// String returns the message of the kind.
+func (k ValidationKind) String() string {
+	if k < 0 || int(k) >= len(validationMessages) {
+		return validationMessages[TopologyValidationError]
+	}
+	return validationMessages[k]
+}
+
+// ValidationKindOf returns the kind of a validation message, TopologyValidationError if the message is unknown.
+func ValidationKindOf(message string) ValidationKind {
+	for i, v := range validationMessages {
+		if v == message {
+			return ValidationKind(i)
+		}
+	}
+	return TopologyValidationError
+}
+
+// ValidationError describes why a steric is not valid, and where.
+type ValidationError struct {
+	Kind     ValidationKind
+	Location matrix.Matrix
+}
+
+// Error returns the reason of the ValidationError, e.g. Self-intersection[0.5 0.5].
+func (e *ValidationError) Error() string {
+	if len(e.Location) < 2 {
+		return e.Kind.String()
+	}
+	return fmt.Sprintf("%v[%v %v]", e.Kind, e.Location[0], e.Location[1])
+}
+
+// Validate returns why the steric is not valid as OGC defines it, nil if it is valid.
+// The elements of a collection are validated on their own, see ValidateMultiPolygon.
+func (el *ValidOP) Validate() *ValidationError {
+	if err := validateCoordinates(el.Steric); err != nil {
+		return err
+	}
+	return validateSteric(el.Steric)
+}
+
+// ValidateMultiPolygon returns why the collection of polygons is not a valid multi polygon, nil if it is valid.
+// The polygons of a multi polygon must be valid, their interiors must not intersect and they may only touch at points.
+func (el *ValidOP) ValidateMultiPolygon() *ValidationError {
+	if err := validateCoordinates(el.Steric); err != nil {
+		return err
+	}
+	coll, ok := el.Steric.(matrix.Collection)
+	if !ok {
+		return validateSteric(el.Steric)
+	}
+	polys := make([]matrix.PolygonMatrix, 0, len(coll))
+	for _, v := range coll {
+		poly, ok := v.(matrix.PolygonMatrix)
+		if !ok {
+			return validateSteric(el.Steric)
+		}
+		if err := validatePolygon(poly); err != nil {
+			return err
+		}
+		if len(poly) > 0 {
+			polys = append(polys, poly)
+		}
+	}
+	for i := range polys {
+		for j := i + 1; j < len(polys); j++ {
+			if err := validatePolygonPair(polys[i], polys[j]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateSteric(steric matrix.Steric) *ValidationError {
+	switch matr := steric.(type) {
+	case matrix.LineMatrix:
+		if len(matr) > 0 && len(graph.RemoveRepeated(matr)) < 2 {
+			return &ValidationError{TooFewPoints, matr[0]}
+		}
+	case matrix.PolygonMatrix:
+		return validatePolygon(matr)
+	case matrix.Collection:
+		for _, v := range matr {
+			if err := validateSteric(v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateCoordinates returns an InvalidCoordinate error at the first coordinate which is not finite.
+func validateCoordinates(steric matrix.Steric) *ValidationError {
+	for _, v := range matrix.TransMatrixes(steric) {
+		if len(v) < 2 {
+			continue
+		}
+		for _, ord := range v[:2] {
+			if math.IsNaN(ord) || math.IsInf(ord, 0) {
+				return &ValidationError{InvalidCoordinate, v}
+			}
+		}
+	}
+	return nil
+}
+
+// validatePolygon validates the rings of the polygon, how the rings intersect and where the holes lie.
+func validatePolygon(poly matrix.PolygonMatrix) *ValidationError {
+	if len(poly) == 0 {
+		return nil
+	}
+	rings := make([]matrix.LineMatrix, len(poly))
+	for i, v := range poly {
+		ring := matrix.LineMatrix(v)
+		if err := validateRing(ring); err != nil {
+			return err
+		}
+		rings[i] = graph.RemoveRepeated(ring)
+	}
+	if err := validateRingIntersections(rings); err != nil {
+		return err
+	}
+
+	shell, holes := rings[0], rings[1:]
+	for _, hole := range holes {
+		if pt, ok := pointNotOnRing(hole, shell); ok && !relate.InPolygon(pt, shell) {
+			return &ValidationError{HoleOutsideShell, pt}
+		}
+	}
+	for i, hole := range holes {
+		for j, other := range holes {
+			if i == j {
+				continue
+			}
+			if pt, ok := pointNotOnRing(hole, other); ok && relate.InPolygon(pt, other) {
+				return &ValidationError{NestedHoles, pt}
+			}
+		}
+	}
+	return nil
+}
+
+// validateRing validates a ring is closed, has enough points and does not self intersect.
+func validateRing(ring matrix.LineMatrix) *ValidationError {
+	if len(ring) == 0 {
+		return nil
+	}
+	if !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+		return &ValidationError{RingNotClosed, ring[0]}
+	}
+	pts := graph.RemoveRepeated(ring)
+	if len(pts) < 4 {
+		return &ValidationError{TooFewPoints, ring[0]}
+	}
+
+	n := len(pts) - 1
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			adjacent := j == i+1 || (i == 0 && j == n-1)
+			kind, loc := intersectSegments(pts[i], pts[i+1], pts[j], pts[j+1])
+			switch {
+			case kind == segmentsCross:
+				return &ValidationError{SelfIntersection, loc}
+			case kind == segmentsTouch && !adjacent:
+				return &ValidationError{RingSelfIntersection, loc}
+			}
+		}
+	}
+	return nil
+}
+
+// validateRingIntersections validates the rings of a polygon only touch at points
+// and the touches do not disconnect the interior.
+// The interior is disconnected if the graph of rings and their touch points has a cycle.
+func validateRingIntersections(rings []matrix.LineMatrix) *ValidationError {
+	nodes := graph.NewUnionFind(len(rings))
+	touches := map[[2]float64]int{}
+	// a ring is attached once to a touch point, whatever the number of other rings touching there.
+	attached := map[[2]int]bool{}
+	for i := range rings {
+		for j := i + 1; j < len(rings); j++ {
+			points, err := ringTouches(rings[i], rings[j])
+			if err != nil {
+				return err
+			}
+			for _, pt := range points {
+				key := [2]float64{pt[0], pt[1]}
+				node, ok := touches[key]
+				if !ok {
+					node = nodes.Add()
+					touches[key] = node
+				}
+				for _, ring := range []int{i, j} {
+					if attached[[2]int{ring, node}] {
+						continue
+					}
+					attached[[2]int{ring, node}] = true
+					if !nodes.Union(ring, node) {
+						return &ValidationError{DisconnectedInterior, pt}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ringTouches returns the distinct points at which two rings touch,
+// or a SelfIntersection error if they cross or share an edge.
+func ringTouches(r0, r1 matrix.LineMatrix) ([]matrix.Matrix, *ValidationError) {
+	points := []matrix.Matrix{}
+	for i := 0; i < len(r0)-1; i++ {
+		for j := 0; j < len(r1)-1; j++ {
+			kind, loc := intersectSegments(r0[i], r0[i+1], r1[j], r1[j+1])
+			switch kind {
+			case segmentsCross:
+				return nil, &ValidationError{SelfIntersection, loc}
+			case segmentsTouch:
+				if !graph.ContainsPoint(points, loc) {
+					points = append(points, loc)
+				}
+			}
+		}
+	}
+	return points, nil
+}
+
+// validatePolygonPair validates two polygons of a multi polygon do not overlap.
+func validatePolygonPair(p0, p1 matrix.PolygonMatrix) *ValidationError {
+	for _, r0 := range p0 {
+		for _, r1 := range p1 {
+			if _, err := ringTouches(graph.RemoveRepeated(r0), graph.RemoveRepeated(r1)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, pair := range [][2]matrix.PolygonMatrix{{p0, p1}, {p1, p0}} {
+		if pt, ok := pointNotOnPolygon(pair[0][0], pair[1]); ok && inPolygonInterior(pt, pair[1]) {
+			return &ValidationError{NestedShells, pt}
+		}
+	}
+	return nil
+}
+
+// inPolygonInterior returns true if pt, which is on no ring of poly, is in the interior of poly.
+func inPolygonInterior(pt matrix.Matrix, poly matrix.PolygonMatrix) bool {
+	if !relate.InPolygon(pt, poly[0]) {
+		return false
+	}
+	for _, hole := range poly[1:] {
+		if relate.InPolygon(pt, hole) {
+			return false
+		}
+	}
+	return true
+}
+
+// pointNotOnRing returns a vertex or a segment midpoint of ring which is not on other.
+func pointNotOnRing(ring, other matrix.LineMatrix) (matrix.Matrix, bool) {
+	return pointNotOnPolygon(ring, matrix.PolygonMatrix{other})
+}
+
+// pointNotOnPolygon returns a vertex or a segment midpoint of ring which is on no ring of poly.
+func pointNotOnPolygon(ring matrix.LineMatrix, poly matrix.PolygonMatrix) (matrix.Matrix, bool) {
+	onPolygon := func(pt matrix.Matrix) bool {
+		for _, v := range poly {
+			if relate.InLineMatrix(pt, v) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, v := range ring {
+		if !onPolygon(v) {
+			return v, true
+		}
+	}
+	for i := 0; i < len(ring)-1; i++ {
+		mid := matrix.Matrix{(ring[i][0] + ring[i+1][0]) / 2, (ring[i][1] + ring[i+1][1]) / 2}
+		if !onPolygon(mid) {
+			return mid, true
+		}
+	}
+	return nil, false
+}
+
+// segmentRelation describes how two segments intersect.
+type segmentRelation int
+
+const (
+	segmentsDisjoint segmentRelation = iota
+	// segmentsTouch the segments share a single point which is an endpoint of one of them.
+	segmentsTouch
+	// segmentsCross the segments cross at an interior point of both or overlap collinearly.
+	segmentsCross
+)
+
+// intersectSegments returns how the segments a0a1 and b0b1 intersect and a point of the intersection.
+func intersectSegments(a0, a1, b0, b1 []float64) (segmentRelation, matrix.Matrix) {
+	ips := graph.Intersection(a0, a1, b0, b1)
+	switch {
+	case len(ips) == 0:
+		return segmentsDisjoint, nil
+	case len(ips) == 1 && graph.ContainsPoint([]matrix.Matrix{a0, a1, b0, b1}, ips[0]):
+		return segmentsTouch, ips[0]
+	default:
+		return segmentsCross, ips[0]
+	}
+}
@@ -0,0 +1,97 @@
+package operation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestValidOP_Validate(t *testing.T) {
+	square := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	tests := []struct {
+		name     string
+		steric   matrix.Steric
+		wantKind ValidationKind
+		wantLoc  matrix.Matrix
+		valid    bool
+	}{
+		{name: "valid polygon", steric: matrix.PolygonMatrix{square, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}}, valid: true},
+		{name: "hole touches shell", steric: matrix.PolygonMatrix{square, {{0, 5}, {2, 6}, {2, 4}, {0, 5}}}, valid: true},
+		{name: "bow tie", steric: matrix.PolygonMatrix{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+			wantKind: SelfIntersection, wantLoc: matrix.Matrix{1, 1}},
+		{name: "inverted shell", steric: matrix.PolygonMatrix{{{0, 0}, {4, 0}, {2, 2}, {3, 3}, {1, 3}, {2, 2}, {0, 0}}},
+			wantKind: RingSelfIntersection, wantLoc: matrix.Matrix{2, 2}},
+		{name: "hole outside shell", steric: matrix.PolygonMatrix{square, {{12, 2}, {12, 4}, {14, 4}, {12, 2}}},
+			wantKind: HoleOutsideShell, wantLoc: matrix.Matrix{12, 2}},
+		{name: "nested holes", steric: matrix.PolygonMatrix{square, {{1, 1}, {1, 9}, {9, 9}, {9, 1}, {1, 1}}, {{2, 2}, {2, 4}, {4, 4}, {2, 2}}},
+			wantKind: NestedHoles, wantLoc: matrix.Matrix{2, 2}},
+		{name: "disconnected interior", steric: matrix.PolygonMatrix{square, {{5, 0}, {10, 5}, {5, 10}, {0, 5}, {5, 0}}},
+			wantKind: DisconnectedInterior, wantLoc: matrix.Matrix{10, 5}},
+		{name: "shell and holes touch at a point", steric: matrix.PolygonMatrix{square,
+			{{5, 0}, {2, 3}, {4, 3}, {5, 0}}, {{5, 0}, {6, 3}, {8, 3}, {5, 0}}}, valid: true},
+		{name: "three holes touch at a point", steric: matrix.PolygonMatrix{square,
+			{{5, 5}, {2, 6}, {2, 8}, {5, 5}}, {{5, 5}, {8, 6}, {8, 8}, {5, 5}}, {{5, 5}, {4, 2}, {6, 2}, {5, 5}}}, valid: true},
+		{name: "holes chain across shell", steric: matrix.PolygonMatrix{square,
+			{{5, 0}, {4, 2.5}, {5, 5}, {6, 2.5}, {5, 0}}, {{5, 5}, {4, 7.5}, {5, 10}, {6, 7.5}, {5, 5}}},
+			wantKind: DisconnectedInterior},
+		{name: "too few points", steric: matrix.PolygonMatrix{{{0, 0}, {1, 1}, {0, 0}}},
+			wantKind: TooFewPoints, wantLoc: matrix.Matrix{0, 0}},
+		{name: "not closed", steric: matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			wantKind: RingNotClosed, wantLoc: matrix.Matrix{0, 0}},
+		{name: "invalid coordinate", steric: matrix.LineMatrix{{0, 0}, {math.NaN(), 1}},
+			wantKind: InvalidCoordinate},
+		{name: "one point line", steric: matrix.LineMatrix{{1, 1}, {1, 1}},
+			wantKind: TooFewPoints, wantLoc: matrix.Matrix{1, 1}},
+		{name: "overlapping collection", steric: matrix.Collection{matrix.PolygonMatrix{square},
+			matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}}, valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			el := &ValidOP{Steric: tt.steric}
+			got := el.Validate()
+			if tt.valid {
+				if got != nil {
+					t.Errorf("ValidOP.Validate() = %v, want valid", got)
+				}
+				return
+			}
+			if got == nil || got.Kind != tt.wantKind || (tt.wantLoc != nil && !got.Location.Equals(tt.wantLoc)) {
+				t.Errorf("ValidOP.Validate() = %v, want %v at %v", got, tt.wantKind, tt.wantLoc)
+			}
+		})
+	}
+}
+
+func TestValidOP_ValidateMultiPolygon(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name   string
+		steric matrix.Collection
+		want   *ValidationError
+	}{
+		{name: "touch at point", steric: matrix.Collection{square, matrix.PolygonMatrix{{{10, 10}, {20, 10}, {20, 20}, {10, 10}}}}},
+		{name: "shell in hole", steric: matrix.Collection{
+			matrix.PolygonMatrix{square[0], {{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}},
+			matrix.PolygonMatrix{{{3, 3}, {3, 4}, {4, 4}, {3, 3}}}}},
+		{name: "overlap", steric: matrix.Collection{square, matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}},
+			want: &ValidationError{SelfIntersection, matrix.Matrix{10, 5}}},
+		{name: "shared edge", steric: matrix.Collection{square, matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}},
+			want: &ValidationError{SelfIntersection, matrix.Matrix{10, 0}}},
+		{name: "nested shells", steric: matrix.Collection{square, matrix.PolygonMatrix{{{3, 3}, {3, 4}, {4, 4}, {3, 3}}}},
+			want: &ValidationError{NestedShells, matrix.Matrix{3, 3}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			el := &ValidOP{Steric: tt.steric}
+			got := el.ValidateMultiPolygon()
+			if (got == nil) != (tt.want == nil) ||
+				(got != nil && (got.Kind != tt.want.Kind || !got.Location.Equals(tt.want.Location))) {
+				t.Errorf("ValidOP.ValidateMultiPolygon() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if got := (&ValidationError{SelfIntersection, matrix.Matrix{1, 2.5}}).Error(); got != "Self-intersection[1 2.5]" {
+		t.Errorf("ValidationError.Error() = %v", got)
+	}
+}
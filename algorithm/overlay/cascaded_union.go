@@ -0,0 +1,113 @@
+package overlay
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index/strtree"
+)
+
+// CascadedUnion returns the union of the polygons.
+// The polygons are unioned in groups following the nodes of a STR tree,
+// so each overlay is of nearby polygons and only the parts near the other operand are noded.
+func CascadedUnion(polys []matrix.PolygonMatrix) matrix.Steric {
+	tree := strtree.NewSTRtree(strtree.DefaultNodeCapacity)
+	for _, v := range polys {
+		if len(v) > 0 && len(v[0]) > 0 {
+			tree.Insert(envelope.Bound(v.Bound()), v)
+		}
+	}
+	return toSteric(unionTree(tree.ItemsTree()))
+}
+
+// unionTree returns the polygons of the union of the items of a node of the tree.
+func unionTree(items []interface{}) []matrix.PolygonMatrix {
+	unions := make([][]matrix.PolygonMatrix, 0, len(items))
+	for _, v := range items {
+		switch item := v.(type) {
+		case []interface{}:
+			unions = append(unions, unionTree(item))
+		case matrix.PolygonMatrix:
+			unions = append(unions, []matrix.PolygonMatrix{item})
+		}
+	}
+	return binaryUnion(unions)
+}
+
+// binaryUnion returns the union of the polygons by a recursive union of each half of them.
+func binaryUnion(unions [][]matrix.PolygonMatrix) []matrix.PolygonMatrix {
+	switch len(unions) {
+	case 0:
+		return nil
+	case 1:
+		if len(unions[0]) == 1 {
+			return unions[0]
+		}
+		return unionOptimized(unions[0], nil)
+	}
+	mid := len(unions) / 2
+	return unionOptimized(binaryUnion(unions[:mid]), binaryUnion(unions[mid:]))
+}
+
+// unionOptimized returns the union of the polygons of a and b,
+// polygons outside the intersection of the envelopes of a and b are not noded.
+func unionOptimized(a, b []matrix.PolygonMatrix) []matrix.PolygonMatrix {
+	envA, envB := polygonsEnvelope(a), polygonsEnvelope(b)
+	if len(a) > 0 && len(b) > 0 && !envA.IsIntersects(envB) {
+		return append(append([]matrix.PolygonMatrix{}, a...), b...)
+	}
+	env := envA
+	if len(b) > 0 {
+		env = envA.Copy().Intersection(envB)
+	}
+
+	near, far := matrix.Collection{}, []matrix.PolygonMatrix{}
+	for _, v := range append(append([]matrix.PolygonMatrix{}, a...), b...) {
+		if envelope.Bound(v.Bound()).IsIntersects(env) {
+			near = append(near, v)
+		} else {
+			far = append(far, v)
+		}
+	}
+	return append(far, polygonsOf(NodedOverlay(near, matrix.Collection{}, OpUnion))...)
+}
+
+// polygonsEnvelope returns the envelope of the polygons.
+func polygonsEnvelope(polys []matrix.PolygonMatrix) *envelope.Envelope {
+	env := envelope.Empty()
+	for _, v := range polys {
+		env.ExpandToIncludeEnv(envelope.Bound(v.Bound()))
+	}
+	return env
+}
+
+// polygonsOf returns the non empty polygons of the steric.
+func polygonsOf(steric matrix.Steric) []matrix.PolygonMatrix {
+	switch m := steric.(type) {
+	case matrix.PolygonMatrix:
+		if len(m) > 0 {
+			return []matrix.PolygonMatrix{m}
+		}
+	case matrix.Collection:
+		polys := []matrix.PolygonMatrix{}
+		for _, v := range m {
+			polys = append(polys, polygonsOf(v)...)
+		}
+		return polys
+	}
+	return nil
+}
+
+// toSteric returns the polygon, the collection of the polygons or an empty polygon.
+func toSteric(polys []matrix.PolygonMatrix) matrix.Steric {
+	switch len(polys) {
+	case 0:
+		return matrix.PolygonMatrix{}
+	case 1:
+		return polys[0]
+	}
+	coll := matrix.Collection{}
+	for _, v := range polys {
+		coll = append(coll, v)
+	}
+	return coll
+}
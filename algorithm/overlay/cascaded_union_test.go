@@ -0,0 +1,81 @@
+package overlay
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestCascadedUnion(t *testing.T) {
+	grid := []matrix.PolygonMatrix{}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			x, y := float64(i), float64(j)
+			grid = append(grid, matrix.PolygonMatrix{{{x, y}, {x + 1, y}, {x + 1, y + 1}, {x, y + 1}, {x, y}}})
+		}
+	}
+	tests := []struct {
+		name  string
+		polys []matrix.PolygonMatrix
+		want  matrix.Steric
+	}{
+		{"grid", grid, matrix.PolygonMatrix{{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 1}, {3, 2}, {3, 3}, {2, 3}, {1, 3}, {0, 3}, {0, 2}, {0, 1}, {0, 0}}}},
+		{"disjoint", []matrix.PolygonMatrix{
+			{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+			{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}},
+			{{{10, 0}, {12, 0}, {12, 2}, {10, 2}, {10, 0}}},
+		}, matrix.Collection{
+			matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 2}, {0, 2}, {0, 0}}},
+			matrix.PolygonMatrix{{{10, 0}, {12, 0}, {12, 2}, {10, 2}, {10, 0}}},
+		}},
+		{"empty", nil, matrix.PolygonMatrix{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CascadedUnion(tt.polys); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CascadedUnion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnaryUnion(t *testing.T) {
+	tests := []struct {
+		name   string
+		steric matrix.Steric
+		want   matrix.Steric
+	}{
+		{"mixed", matrix.Collection{
+			matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+			matrix.LineMatrix{{1, 1}, {5, 1}},
+			matrix.Matrix{1, 1.5},
+			matrix.Matrix{6, 6},
+			matrix.PolygonMatrix{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}},
+		}, matrix.Collection{
+			matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 2}, {0, 2}, {0, 0}}},
+			matrix.LineMatrix{{3, 1}, {5, 1}},
+			matrix.Matrix{6, 6},
+		}},
+		{"lines", matrix.Collection{matrix.LineMatrix{{0, 0}, {2, 2}}, matrix.LineMatrix{{0, 2}, {2, 0}}, matrix.Matrix{1, 1}},
+			matrix.Collection{
+				matrix.LineMatrix{{0, 0}, {1, 1}}, matrix.LineMatrix{{1, 1}, {2, 2}},
+				matrix.LineMatrix{{0, 2}, {1, 1}}, matrix.LineMatrix{{1, 1}, {2, 0}},
+			}},
+		{"self crossing line", matrix.LineMatrix{{0, 0}, {2, 2}, {2, 0}, {0, 2}}, matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {1, 1}}, matrix.LineMatrix{{1, 1}, {2, 2}, {2, 0}, {1, 1}}, matrix.LineMatrix{{1, 1}, {0, 2}},
+		}},
+		{"overlapping lines", matrix.Collection{matrix.LineMatrix{{0, 0}, {4, 0}}, matrix.LineMatrix{{2, 0}, {6, 0}}},
+			matrix.Collection{
+				matrix.LineMatrix{{0, 0}, {2, 0}}, matrix.LineMatrix{{2, 0}, {4, 0}}, matrix.LineMatrix{{4, 0}, {6, 0}},
+			}},
+		{"empty", matrix.Collection{}, matrix.Collection{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnaryUnion(tt.steric); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UnaryUnion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
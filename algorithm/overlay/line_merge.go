@@ -9,7 +9,7 @@ import (
 //	or an empty atomic geometry, or an empty GEOMETRYCOLLECTION
 func LineMerge(ml matrix.Collection) matrix.Collection {
 	for i := 0; i < len(ml)-1; i++ {
-		for j := 1; j < len(ml); j++ {
+		for j := i + 1; j < len(ml); j++ {
 			if mlMerge, ok := MergeLine(ml, i, j); ok {
 				ml = mlMerge
 				return LineMerge(ml)
@@ -32,6 +32,12 @@ func MergeLine(ml matrix.Collection, i, j int) (matrix.Collection, bool) {
 	if ml[j] == nil {
 		return ml, false
 	}
+	if _, ok := ml[i].(matrix.LineMatrix); !ok {
+		return ml, false
+	}
+	if _, ok := ml[j].(matrix.LineMatrix); !ok {
+		return ml, false
+	}
 	var result matrix.Collection
 	mark, ips := relate.IntersectionEdge(ml[i].(matrix.LineMatrix), ml[j].(matrix.LineMatrix))
 	if mark {
@@ -42,26 +48,26 @@ func MergeLine(ml matrix.Collection, i, j int) (matrix.Collection, bool) {
 					if i < j {
 						temp1, temp2 := ml[i+1:j], ml[j+1:]
 						if i > 0 {
-							result = append(result, ml[:i])
+							result = append(result, ml[:i]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
 					if i > j {
 						temp1, temp2 := ml[j+1:i], ml[i+1:]
 						if j > 0 {
-							result = append(result, ml[:j])
+							result = append(result, ml[:j]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
@@ -74,26 +80,26 @@ func MergeLine(ml matrix.Collection, i, j int) (matrix.Collection, bool) {
 					if i < j {
 						temp1, temp2 := ml[i+1:j], ml[j+1:]
 						if i > 0 {
-							result = append(result, ml[:i])
+							result = append(result, ml[:i]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
 					if i > j {
 						temp1, temp2 := ml[j+1:i], ml[i+1:]
 						if j > 0 {
-							result = append(result, ml[:j])
+							result = append(result, ml[:j]...)
 						}
 						if temp1 != nil && len(temp1) > 0 {
-							result = append(result, temp1)
+							result = append(result, temp1...)
 						}
 						if temp2 != nil && len(temp2) > 0 {
-							result = append(result, temp2)
+							result = append(result, temp2...)
 						}
 						result = append(result, r1)
 					}
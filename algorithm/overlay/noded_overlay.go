@@ -0,0 +1,463 @@
+package overlay
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Op is an operation of the noded overlay.
+type Op int
+
+// const noded overlay operations.
+const (
+	OpIntersection Op = iota
+	OpUnion
+	OpDifference
+	OpSymDifference
+)
+
+// const locations of a point to an area.
+const (
+	exterior = iota
+	boundary
+	interior
+)
+
+// components are the points, lines and polygons of a steric.
+type components struct {
+	points []matrix.Matrix
+	lines  []matrix.LineMatrix
+	polys  []matrix.PolygonMatrix
+}
+
+// NodedOverlay returns the overlay of any two sterics, which may be points, lines, polygons or collections of them.
+// The rings of the polygons are noded into a planar graph and the faces are selected by the operation,
+// the lines are split at the linework of the other steric and the pieces are selected by their location,
+// points are kept if they are not covered by the polygons or lines of the result.
+// Vertices and nodes closer than a tolerance relative to the coordinates are merged,
+// so that the rounding errors of computed vertices do not leave slivers.
+// The result is the polygons, lines and points of the overlay, an empty result is an empty steric of the dimension of the operation.
+func NodedOverlay(m0, m1 matrix.Steric, op Op) matrix.Steric {
+	a, b := &components{}, &components{}
+	a.add(m0)
+	b.add(m1)
+	s := newSnapper(a, b)
+	a, b = a.snap(s), b.snap(s)
+
+	polys, touches := overlayAreas(a, b, s, op)
+	area := &components{polys: polys}
+
+	lines := []matrix.LineMatrix{}
+	for _, v := range append(overlayLines(a, b, op, false), overlayLines(b, a, op, true)...) {
+		if area.locate(midPoint(v)) == exterior {
+			lines = append(lines, v)
+		}
+	}
+	lines = append(lines, touches...)
+	linework := &components{lines: lines, polys: polys}
+
+	points := []matrix.Matrix{}
+	for _, v := range overlayPoints(a, b, op) {
+		if !linework.covers(v) && !graph.ContainsPoint(points, v) {
+			points = append(points, v)
+		}
+	}
+
+	result := matrix.Collection{}
+	for _, v := range polys {
+		result = append(result, v)
+	}
+	for _, v := range lines {
+		result = append(result, v)
+	}
+	for _, v := range points {
+		result = append(result, v)
+	}
+	switch len(result) {
+	case 0:
+		return emptySteric(op.dimension(a.dimension(), b.dimension()))
+	case 1:
+		return result[0]
+	}
+	return result
+}
+
+// add adds the elements of the steric to the components, rings are closed and repeated points removed.
+func (c *components) add(steric matrix.Steric) {
+	switch m := steric.(type) {
+	case matrix.Matrix:
+		if len(m) >= 2 {
+			c.points = append(c.points, m)
+		}
+	case matrix.LineMatrix:
+		if line := graph.RemoveRepeated(m); len(line) > 1 {
+			c.lines = append(c.lines, line)
+		} else if len(line) == 1 {
+			c.points = append(c.points, line[0])
+		}
+	case matrix.PolygonMatrix:
+		poly := matrix.PolygonMatrix{}
+		for i, v := range m {
+			ring := graph.RemoveRepeated(v)
+			if len(ring) < 3 && i == 0 {
+				return
+			}
+			if len(ring) < 3 {
+				continue
+			}
+			if !matrix.Matrix(ring[0]).Equals(matrix.Matrix(ring[len(ring)-1])) {
+				ring = append(ring, ring[0])
+			}
+			poly = append(poly, ring)
+		}
+		if len(poly) > 0 {
+			c.polys = append(c.polys, poly)
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			c.add(v)
+		}
+	}
+}
+
+// snap returns the components with their vertices merged by the snapper.
+func (c *components) snap(s *snapper) *components {
+	snapped := &components{}
+	for _, v := range c.points {
+		snapped.add(s.snap(v))
+	}
+	for _, v := range c.lines {
+		snapped.add(s.snapLine(v))
+	}
+	for _, v := range c.polys {
+		poly := matrix.PolygonMatrix{}
+		for _, ring := range v {
+			poly = append(poly, s.snapLine(ring))
+		}
+		snapped.add(poly)
+	}
+	return snapped
+}
+
+// dimension returns the highest dimension of the components, -1 if there is none.
+func (c *components) dimension() int {
+	switch {
+	case len(c.polys) > 0:
+		return 2
+	case len(c.lines) > 0:
+		return 1
+	case len(c.points) > 0:
+		return 0
+	}
+	return -1
+}
+
+// linework returns the lines and the rings of the components.
+func (c *components) linework() []matrix.LineMatrix {
+	lines := append([]matrix.LineMatrix{}, c.lines...)
+	for _, poly := range c.polys {
+		for _, ring := range poly {
+			lines = append(lines, ring)
+		}
+	}
+	return lines
+}
+
+// locate returns the location of the point to the area of the polygons.
+func (c *components) locate(pt matrix.Matrix) int {
+	loc := exterior
+	for _, poly := range c.polys {
+		onRing := false
+		for _, ring := range poly {
+			if graph.OnLine(pt, ring) {
+				onRing = true
+				break
+			}
+		}
+		if onRing {
+			loc = boundary
+			continue
+		}
+		if graph.InsidePolygon(pt, poly) {
+			return interior
+		}
+	}
+	return loc
+}
+
+// onLines returns true if the point is on a line of the components.
+func (c *components) onLines(pt matrix.Matrix) bool {
+	for _, line := range c.lines {
+		if graph.OnLine(pt, line) {
+			return true
+		}
+	}
+	return false
+}
+
+// covers returns true if the point is on a point, on a line or not in the exterior of the polygons of the components.
+func (c *components) covers(pt matrix.Matrix) bool {
+	return graph.ContainsPoint(c.points, pt) || c.onLines(pt) || c.locate(pt) != exterior
+}
+
+// selects returns true if a point inside a and b as given is in the result of the operation.
+func (op Op) selects(inA, inB bool) bool {
+	switch op {
+	case OpIntersection:
+		return inA && inB
+	case OpUnion:
+		return inA || inB
+	case OpDifference:
+		return inA && !inB
+	default:
+		return inA != inB
+	}
+}
+
+// dimension returns the dimension of the result of the operation of sterics of dimensions dimA and dimB.
+func (op Op) dimension(dimA, dimB int) int {
+	switch op {
+	case OpIntersection:
+		if dimA < dimB {
+			return dimA
+		}
+		return dimB
+	case OpDifference:
+		return dimA
+	default:
+		if dimA > dimB {
+			return dimA
+		}
+		return dimB
+	}
+}
+
+// overlayAreas returns the polygons of the overlay of the areas,
+// and for an intersection the lines where the areas touch.
+// The nodes of the noded rings are merged by the snapper.
+func overlayAreas(a, b *components, s *snapper, op Op) ([]matrix.PolygonMatrix, []matrix.LineMatrix) {
+	rings := append((&components{polys: a.polys}).linework(), (&components{polys: b.polys}).linework()...)
+	if len(rings) == 0 {
+		return nil, nil
+	}
+
+	segments := []matrix.LineMatrix{}
+	seen := map[[4]float64]bool{}
+	for _, v := range graph.NodeLines(rings) {
+		seg := s.snapLine(v)
+		key := graph.SegmentKey(seg[0], seg[1])
+		if matrix.Matrix(seg[0]).Equals(matrix.Matrix(seg[1])) || seen[key] {
+			continue
+		}
+		seen[key] = true
+		segments = append(segments, seg)
+	}
+
+	g := graph.NewPlanarGraph(segments)
+	faces := g.Faces()
+	inA, inB := make([]bool, len(faces)), make([]bool, len(faces))
+	selected := make([]bool, len(faces))
+	for i, f := range faces {
+		if !f.IsBounded() {
+			continue
+		}
+		pt := graph.InteriorPoint(f.Ring, f.Holes)
+		inA[i], inB[i] = graph.InsidePolygons(pt, a.polys), graph.InsidePolygons(pt, b.polys)
+		selected[i] = op.selects(inA[i], inB[i])
+	}
+
+	polys := g.Polygons(func(e int) bool {
+		return selected[g.Edges[e].Face] && !selected[g.Edges[graph.Twin(e)].Face]
+	})
+	if op != OpIntersection {
+		return polys, nil
+	}
+	onlyA := func(face int) bool { return inA[face] && !inB[face] }
+	onlyB := func(face int) bool { return inB[face] && !inA[face] }
+	touches := g.Chains(func(e int) bool {
+		face, twin := g.Edges[e].Face, g.Edges[graph.Twin(e)].Face
+		return (onlyA(face) && onlyB(twin)) || (onlyB(face) && onlyA(twin))
+	})
+	return polys, touches
+}
+
+// overlayLines returns the pieces of the lines of a split at the linework of both,
+// which are in the result of the operation, reverse is true if a is the second steric of the operation.
+func overlayLines(a, b *components, op Op, reverse bool) []matrix.LineMatrix {
+	pieces := []matrix.LineMatrix{}
+	for i, line := range a.lines {
+		linework := append(b.linework(), a.linework()...)
+		linework = append(linework[:len(b.linework())+i], linework[len(b.linework())+i+1:]...)
+		for _, piece := range splitLine(line, linework) {
+			mid := midPoint(piece)
+			inB := b.covers(mid)
+			var keep bool
+			switch op {
+			case OpIntersection:
+				// a piece on a line of both is kept once, as a piece of the first steric.
+				keep = inB && !(reverse && b.onLines(mid))
+			case OpUnion:
+				keep = !(reverse && b.onLines(mid))
+			case OpDifference:
+				keep = !reverse && !inB
+			default:
+				keep = !inB
+			}
+			if keep && !duplicatePiece(pieces, piece) {
+				pieces = append(pieces, piece)
+			}
+		}
+	}
+	return pieces
+}
+
+// overlayPoints returns the points of the result of the operation,
+// which are the points of a and b and for an intersection the intersections of their linework.
+func overlayPoints(a, b *components, op Op) []matrix.Matrix {
+	points := []matrix.Matrix{}
+	for _, v := range a.points {
+		if inB := b.covers(v); (op == OpIntersection && inB) || op == OpUnion || (op >= OpDifference && !inB) {
+			points = append(points, v)
+		}
+	}
+	for _, v := range b.points {
+		if inA := a.covers(v); (op == OpIntersection && inA) || op == OpUnion || (op == OpSymDifference && !inA) {
+			points = append(points, v)
+		}
+	}
+	if op != OpIntersection {
+		return points
+	}
+	linesB := b.linework()
+	for _, line := range a.linework() {
+		for i := 0; i < len(line)-1; i++ {
+			for _, other := range linesB {
+				for j := 0; j < len(other)-1; j++ {
+					points = append(points, graph.Intersection(line[i], line[i+1], other[j], other[j+1])...)
+				}
+			}
+		}
+	}
+	return points
+}
+
+// splitLine returns the pieces of the line between its intersections with the linework.
+func splitLine(line matrix.LineMatrix, linework []matrix.LineMatrix) []matrix.LineMatrix {
+	pieces := []matrix.LineMatrix{}
+	piece := matrix.LineMatrix{line[0]}
+	for i := 0; i < len(line)-1; i++ {
+		start, end := matrix.Matrix(line[i]), matrix.Matrix(line[i+1])
+		nodes := []matrix.Matrix{}
+		for _, other := range linework {
+			for j := 0; j < len(other)-1; j++ {
+				nodes = append(nodes, graph.Intersection(start, end, other[j], other[j+1])...)
+			}
+		}
+		sort.Slice(nodes, func(i, j int) bool {
+			return graph.SquareDistance(start, nodes[i]) < graph.SquareDistance(start, nodes[j])
+		})
+		for _, node := range nodes {
+			if !node.Equals(matrix.Matrix(piece[len(piece)-1])) {
+				piece = append(piece, node)
+			}
+			if len(piece) > 1 {
+				pieces = append(pieces, piece)
+				piece = matrix.LineMatrix{node}
+			}
+		}
+		if !end.Equals(matrix.Matrix(piece[len(piece)-1])) {
+			piece = append(piece, end)
+		}
+	}
+	if len(piece) > 1 {
+		pieces = append(pieces, piece)
+	}
+	return pieces
+}
+
+// duplicatePiece returns true if the piece or its reverse is one of the pieces.
+func duplicatePiece(pieces []matrix.LineMatrix, piece matrix.LineMatrix) bool {
+	for _, v := range pieces {
+		if len(v) != len(piece) {
+			continue
+		}
+		same, reverse := true, true
+		for i := range v {
+			same = same && matrix.Matrix(v[i]).Equals(matrix.Matrix(piece[i]))
+			reverse = reverse && matrix.Matrix(v[i]).Equals(matrix.Matrix(piece[len(piece)-1-i]))
+		}
+		if same || reverse {
+			return true
+		}
+	}
+	return false
+}
+
+// midPoint returns the middle of the first segment of the line.
+func midPoint(line matrix.LineMatrix) matrix.Matrix {
+	return matrix.Matrix{(line[0][0] + line[1][0]) / 2, (line[0][1] + line[1][1]) / 2}
+}
+
+// emptySteric returns an empty steric of the dimension.
+func emptySteric(dimension int) matrix.Steric {
+	switch dimension {
+	case 0:
+		return matrix.Matrix{}
+	case 1:
+		return matrix.LineMatrix{}
+	case 2:
+		return matrix.PolygonMatrix{}
+	}
+	return matrix.Collection{}
+}
+
+// snapper merges the points closer than its tolerance into the first of them.
+type snapper struct {
+	tolerance float64
+	cells     map[[2]float64][]matrix.Matrix
+}
+
+// newSnapper returns a snapper with a tolerance relative to the magnitude of the coordinates of the components.
+func newSnapper(components ...*components) *snapper {
+	scale := 1.0
+	for _, c := range components {
+		for _, pt := range c.points {
+			scale = math.Max(scale, math.Max(math.Abs(pt[0]), math.Abs(pt[1])))
+		}
+		for _, line := range c.linework() {
+			for _, pt := range line {
+				scale = math.Max(scale, math.Max(math.Abs(pt[0]), math.Abs(pt[1])))
+			}
+		}
+	}
+	return &snapper{tolerance: 1e-12 * scale, cells: map[[2]float64][]matrix.Matrix{}}
+}
+
+// snap returns the first point snapped within the tolerance of the point, or the point itself.
+func (s *snapper) snap(pt matrix.Matrix) matrix.Matrix {
+	cx, cy := math.Floor(pt[0]/s.tolerance), math.Floor(pt[1]/s.tolerance)
+	for dx := -1.0; dx <= 1; dx++ {
+		for dy := -1.0; dy <= 1; dy++ {
+			for _, v := range s.cells[[2]float64{cx + dx, cy + dy}] {
+				if math.Abs(v[0]-pt[0]) <= s.tolerance && math.Abs(v[1]-pt[1]) <= s.tolerance {
+					return v
+				}
+			}
+		}
+	}
+	key := [2]float64{cx, cy}
+	s.cells[key] = append(s.cells[key], pt)
+	return pt
+}
+
+// snapLine returns the line with its points snapped.
+func (s *snapper) snapLine(line matrix.LineMatrix) matrix.LineMatrix {
+	snapped := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		snapped[i] = s.snap(v)
+	}
+	return snapped
+}
@@ -0,0 +1,62 @@
+package overlay
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestNodedOverlay(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	squareWithHole := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}, {{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+	hole := matrix.PolygonMatrix{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}}
+
+	type args struct {
+		m0, m1 matrix.Steric
+		op     Op
+	}
+	tests := []struct {
+		name string
+		args args
+		want matrix.Steric
+	}{
+		{"intersection hole", args{squareWithHole, matrix.PolygonMatrix{{{5, 0}, {15, 0}, {15, 10}, {5, 10}, {5, 0}}}, OpIntersection},
+			matrix.PolygonMatrix{{{5, 0}, {10, 0}, {10, 10}, {5, 10}, {5, 6}, {6, 6}, {6, 4}, {5, 4}, {5, 0}}}},
+		{"union fill hole", args{squareWithHole, hole, OpUnion}, square},
+		{"union multi polygon", args{
+			matrix.Collection{matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, matrix.PolygonMatrix{{{4, 0}, {6, 0}, {6, 2}, {4, 2}, {4, 0}}}},
+			matrix.PolygonMatrix{{{1, 1}, {5, 1}, {5, 3}, {1, 3}, {1, 1}}}, OpUnion},
+			matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 1}, {4, 1}, {4, 0}, {6, 0}, {6, 2}, {5, 2}, {5, 3}, {1, 3}, {1, 2}, {0, 2}, {0, 0}}}},
+		{"union near coincident", args{square, matrix.PolygonMatrix{{{10, 1e-17}, {20, 0}, {20, 10}, {10, 10}, {10, 1e-17}}}, OpUnion},
+			matrix.PolygonMatrix{{{0, 0}, {10, 0}, {20, 0}, {20, 10}, {10, 10}, {0, 10}, {0, 0}}}},
+		{"symdifference", args{square, matrix.PolygonMatrix{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}}, OpSymDifference},
+			matrix.Collection{
+				matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 5}, {5, 5}, {5, 10}, {0, 10}, {0, 0}}},
+				matrix.PolygonMatrix{{{10, 10}, {10, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 10}, {10, 10}}},
+			}},
+		{"touch edge", args{square, matrix.PolygonMatrix{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}}, OpIntersection},
+			matrix.LineMatrix{{10, 0}, {10, 10}}},
+		{"touch point", args{square, matrix.PolygonMatrix{{{10, 10}, {20, 10}, {20, 20}, {10, 20}, {10, 10}}}, OpIntersection},
+			matrix.Matrix{10, 10}},
+		{"disjoint", args{square, matrix.PolygonMatrix{{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}}, OpIntersection},
+			matrix.PolygonMatrix{}},
+		{"line polygon", args{matrix.LineMatrix{{-5, 5}, {15, 5}}, square, OpIntersection}, matrix.LineMatrix{{0, 5}, {10, 5}}},
+		{"line polygon difference", args{matrix.LineMatrix{{-5, 5}, {15, 5}}, square, OpDifference},
+			matrix.Collection{matrix.LineMatrix{{-5, 5}, {0, 5}}, matrix.LineMatrix{{10, 5}, {15, 5}}}},
+		{"polygon line difference", args{square, matrix.LineMatrix{{0, 0}, {10, 10}}, OpDifference}, square},
+		{"point polygon", args{matrix.Matrix{20, 5}, square, OpUnion}, matrix.Collection{square, matrix.Matrix{20, 5}}},
+		{"crossing lines", args{matrix.LineMatrix{{0, 0}, {10, 10}}, matrix.LineMatrix{{0, 10}, {10, 0}}, OpIntersection}, matrix.Matrix{5, 5}},
+		{"collection", args{
+			matrix.Collection{square, matrix.LineMatrix{{20, 0}, {20, 10}}, matrix.Matrix{30, 30}},
+			matrix.PolygonMatrix{{{5, -5}, {25, -5}, {25, 5}, {5, 5}, {5, -5}}}, OpIntersection},
+			matrix.Collection{matrix.PolygonMatrix{{{5, 0}, {10, 0}, {10, 5}, {5, 5}, {5, 0}}}, matrix.LineMatrix{{20, 0}, {20, 5}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NodedOverlay(tt.args.m0, tt.args.m1, tt.args.op); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NodedOverlay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,225 @@
+package overlay
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+// PolygonizeResult the polygons formed from the linework, and the lines which are not part of a polygon.
+type PolygonizeResult struct {
+	Polygons []matrix.PolygonMatrix
+	// Dangles are the lines with an end which is not joined to other lines.
+	Dangles []matrix.LineMatrix
+	// CutEdges are the lines joined at both ends, which are not on the boundary of a polygon.
+	CutEdges []matrix.LineMatrix
+	// InvalidRings are the closed lines which cross themselves.
+	InvalidRings []matrix.LineMatrix
+}
+
+// Polygonize Computes the polygons formed by the linework of the geometry, which must be correctly noded,
+// the lines must only meet at their vertices.
+// The linework is split at its vertices into a planar graph, the dangles and then the cut edges are removed from it,
+// and each face of the graph becomes a polygon, the face of a ring inside another face becomes its hole.
+// The dangles and the cut edges are merged into lines with LineMerge, in the direction of the input.
+func Polygonize(geom matrix.Steric) *PolygonizeResult {
+	c := &components{}
+	c.add(geom)
+	g := graph.NewPlanarGraph(uniqueSegments(c.linework()))
+	removed := make([]bool, len(g.Edges)/2)
+	kept := func(e int) bool { return !removed[e/2] }
+	result := &PolygonizeResult{Dangles: mergeSegments(g, removeDangles(g, removed))}
+
+	// a cut edge has the same face on both sides
+	faces := make([]int, len(g.Edges))
+	for i, cycle := range g.Cycles(kept) {
+		for _, e := range cycle {
+			faces[e] = i
+		}
+	}
+	cutEdges := []int{}
+	for e := 0; e < len(g.Edges); e += 2 {
+		if kept(e) && faces[e] == faces[graph.Twin(e)] {
+			cutEdges = append(cutEdges, e/2)
+		}
+	}
+	for _, k := range cutEdges {
+		removed[k] = true
+	}
+	result.CutEdges = mergeSegments(g, cutEdges)
+
+	shells, holes := []matrix.LineMatrix{}, []matrix.LineMatrix{}
+	// the faces on both sides of an invalid ring have the same boundary, it is reported once
+	invalid := map[string]bool{}
+	for _, cycle := range g.Cycles(kept) {
+		for _, ring := range graph.SimpleRings(g.Ring(cycle)) {
+			switch {
+			case !isSimpleRing(ring):
+				if key := ringKey(ring); !invalid[key] {
+					invalid[key] = true
+					result.InvalidRings = append(result.InvalidRings, ring)
+				}
+			case graph.SignedArea(ring) > 0:
+				shells = append(shells, ring)
+			default:
+				holes = append(holes, ring)
+			}
+		}
+	}
+	result.Polygons = assignHoles(shells, holes)
+	return result
+}
+
+// uniqueSegments returns the segments of the lines in their direction, without zero length and duplicate segments.
+func uniqueSegments(lines []matrix.LineMatrix) []matrix.LineMatrix {
+	segments := []matrix.LineMatrix{}
+	seen := map[[4]float64]bool{}
+	for _, line := range lines {
+		for i := 0; i < len(line)-1; i++ {
+			key := graph.SegmentKey(line[i], line[i+1])
+			if matrix.Matrix(line[i]).Equals(matrix.Matrix(line[i+1])) || seen[key] {
+				continue
+			}
+			seen[key] = true
+			segments = append(segments, matrix.LineMatrix{line[i], line[i+1]})
+		}
+	}
+	return segments
+}
+
+// removeDangles removes the segments with a node of degree one, until none is left, and returns them.
+func removeDangles(g *graph.PlanarGraph, removed []bool) []int {
+	incident := make([][]int, len(g.Nodes))
+	for e := 0; e < len(g.Edges); e += 2 {
+		incident[g.Edges[e].From] = append(incident[g.Edges[e].From], e)
+		incident[g.Edges[e].To] = append(incident[g.Edges[e].To], e)
+	}
+	degree := make([]int, len(g.Nodes))
+	stack := []int{}
+	for v, edges := range incident {
+		if degree[v] = len(edges); degree[v] == 1 {
+			stack = append(stack, v)
+		}
+	}
+	dangles := []int{}
+	for len(stack) > 0 {
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, e := range incident[v] {
+			if removed[e/2] {
+				continue
+			}
+			removed[e/2] = true
+			dangles = append(dangles, e/2)
+			w := g.Edges[e].From + g.Edges[e].To - v
+			degree[v]--
+			if degree[w]--; degree[w] == 1 {
+				stack = append(stack, w)
+			}
+		}
+	}
+	return dangles
+}
+
+// mergeSegments returns the segments merged into lines, the consecutive segments of an input line are joined first.
+func mergeSegments(g *graph.PlanarGraph, segments []int) []matrix.LineMatrix {
+	sort.Ints(segments)
+	ml := matrix.Collection{}
+	for i, k := range segments {
+		if i > 0 && g.Edges[2*segments[i-1]].To == g.Edges[2*k].From {
+			last := ml[len(ml)-1].(matrix.LineMatrix)
+			ml[len(ml)-1] = append(last, g.Nodes[g.Edges[2*k].To])
+			continue
+		}
+		ml = append(ml, g.Segment(2*k))
+	}
+	lines := []matrix.LineMatrix{}
+	for _, v := range LineMerge(ml) {
+		lines = append(lines, v.(matrix.LineMatrix))
+	}
+	return lines
+}
+
+// isSimpleRing returns true if no two segments of the ring intersect, except the neighbors at their shared vertex.
+func isSimpleRing(ring matrix.LineMatrix) bool {
+	n := len(ring) - 1
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	minX := func(i int) float64 { return math.Min(ring[i][0], ring[i+1][0]) }
+	maxX := func(i int) float64 { return math.Max(ring[i][0], ring[i+1][0]) }
+	sort.Slice(order, func(a, b int) bool { return minX(order[a]) < minX(order[b]) })
+	for k, i := range order {
+		for _, j := range order[k+1:] {
+			if minX(j) > maxX(i) {
+				break
+			}
+			if j == (i+1)%n || i == (j+1)%n {
+				continue
+			}
+			if mark, _ := relate.Intersection(ring[i], ring[i+1], ring[j], ring[j+1]); mark {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ringKey returns the same key for the ring in both directions.
+func ringKey(ring matrix.LineMatrix) string {
+	segments := make([][4]float64, 0, len(ring)-1)
+	for i := 0; i < len(ring)-1; i++ {
+		segments = append(segments, graph.SegmentKey(ring[i], ring[i+1]))
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		for k := range segments[i] {
+			if segments[i][k] != segments[j][k] {
+				return segments[i][k] < segments[j][k]
+			}
+		}
+		return false
+	})
+	return fmt.Sprint(segments)
+}
+
+// assignHoles returns the polygons of the shells with the holes inside them, a hole belongs to the smallest shell
+// containing it, the holes outside all shells are the outer boundaries of the linework and are dropped.
+func assignHoles(shells, holes []matrix.LineMatrix) []matrix.PolygonMatrix {
+	polygons := make([]matrix.PolygonMatrix, len(shells))
+	areas := make([]float64, len(shells))
+	for i, shell := range shells {
+		polygons[i] = matrix.PolygonMatrix{shell}
+		areas[i] = graph.SignedArea(shell)
+	}
+	for _, hole := range holes {
+		best := -1
+		for i, shell := range shells {
+			if (best == -1 || areas[i] < areas[best]) && ringInside(hole, shell) {
+				best = i
+			}
+		}
+		if best != -1 {
+			polygons[best] = append(polygons[best], hole)
+		}
+	}
+	return polygons
+}
+
+// ringInside returns true if the ring is inside the shell, tested at its first vertex or segment midpoint
+// which is not on the shell, a ring on the shell is not inside it.
+func ringInside(ring, shell matrix.LineMatrix) bool {
+	for i := 0; i < len(ring)-1; i++ {
+		mid := matrix.Matrix{(ring[i][0] + ring[i+1][0]) / 2, (ring[i][1] + ring[i+1][1]) / 2}
+		for _, p := range []matrix.Matrix{ring[i], mid} {
+			if !graph.OnLine(p, shell) {
+				return relate.InPolygon(p, shell)
+			}
+		}
+	}
+	return false
+}
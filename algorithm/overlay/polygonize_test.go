@@ -0,0 +1,100 @@
+package overlay
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestPolygonize(t *testing.T) {
+	tests := []struct {
+		name         string
+		lines        matrix.Collection
+		areas        []float64
+		holes        int
+		dangles      []matrix.LineMatrix
+		cutEdges     []matrix.LineMatrix
+		invalidRings int
+	}{
+		{name: "two squares", lines: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 0}, {20, 0}, {20, 10}, {10, 10}, {0, 10}, {0, 0}},
+			matrix.LineMatrix{{10, 0}, {10, 10}},
+		}, areas: []float64{100, 100}},
+		{name: "dangles", lines: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 0}, {10, 5}, {10, 10}, {0, 10}, {0, 5}, {0, 0}},
+			matrix.LineMatrix{{10, 5}, {15, 5}},
+			matrix.LineMatrix{{15, 5}, {20, 5}, {20, 8}},
+			matrix.LineMatrix{{0, 5}, {10, 5}},
+			matrix.LineMatrix{{-5, -5}, {-1, -1}},
+		}, areas: []float64{50, 50},
+			dangles: []matrix.LineMatrix{{{10, 5}, {15, 5}, {20, 5}, {20, 8}}, {{-5, -5}, {-1, -1}}}},
+		{name: "cut edge", lines: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 0}, {10, 5}, {10, 10}, {0, 10}, {0, 0}},
+			matrix.LineMatrix{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 5}, {20, 0}},
+			matrix.LineMatrix{{10, 5}, {15, 5}, {20, 5}},
+		}, areas: []float64{100, 100}, cutEdges: []matrix.LineMatrix{{{10, 5}, {15, 5}, {20, 5}}}},
+		{name: "island", lines: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			matrix.LineMatrix{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+		}, areas: []float64{96, 4}, holes: 1},
+		{name: "touching island", lines: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			matrix.LineMatrix{{0, 0}, {4, 2}, {2, 4}, {0, 0}},
+		}, areas: []float64{94, 6}, holes: 1},
+		{name: "invalid ring", lines: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 10}, {10, 0}, {0, 10}, {0, 0}},
+		}, invalidRings: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Polygonize(tt.lines)
+			if len(got.Polygons) != len(tt.areas) {
+				t.Fatalf("Polygonize() = %v polygons, want %v", len(got.Polygons), len(tt.areas))
+			}
+			holes := 0
+			for i, poly := range got.Polygons {
+				area := math.Abs(measure.AreaDirection(poly[0]))
+				for _, hole := range poly[1:] {
+					area -= math.Abs(measure.AreaDirection(hole))
+				}
+				if math.Abs(area-tt.areas[i]) > 1e-9 {
+					t.Errorf("Polygonize() polygon %v area = %v, want %v", i, area, tt.areas[i])
+				}
+				if measure.AreaDirection(poly[0]) >= 0 {
+					t.Errorf("Polygonize() shell %v is not counter clockwise", i)
+				}
+				holes += len(poly) - 1
+			}
+			if holes != tt.holes {
+				t.Errorf("Polygonize() holes = %v, want %v", holes, tt.holes)
+			}
+			if !equalLines(got.Dangles, tt.dangles) {
+				t.Errorf("Polygonize() dangles = %v, want %v", got.Dangles, tt.dangles)
+			}
+			if !equalLines(got.CutEdges, tt.cutEdges) {
+				t.Errorf("Polygonize() cut edges = %v, want %v", got.CutEdges, tt.cutEdges)
+			}
+			if len(got.InvalidRings) != tt.invalidRings {
+				t.Errorf("Polygonize() invalid rings = %v, want %v", got.InvalidRings, tt.invalidRings)
+			}
+		})
+	}
+}
+
+func equalLines(got, want []matrix.LineMatrix) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for _, w := range want {
+		found := false
+		for _, g := range got {
+			found = found || g.Equals(w)
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package overlay
+
+import (
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Split Computes the pieces of the input cut by the blade.
+// A line is split at the points of the blade lying on it and where it meets the lines and rings of the blade.
+// A polygon is split by the lines and rings of the blade crossing it, each hole is kept in the piece containing it,
+// the parts of the blade outside the polygon or ending inside it do not split it.
+// The pieces of a line are in its direction, the input is returned as the only piece if the blade does not split it.
+func Split(input, blade matrix.Steric) matrix.Collection {
+	b := &components{}
+	b.add(blade)
+	result := matrix.Collection{}
+	switch m := input.(type) {
+	case matrix.LineMatrix:
+		linework := b.linework()
+		for _, v := range b.points {
+			linework = append(linework, matrix.LineMatrix{v, v})
+		}
+		for _, v := range splitLine(m, linework) {
+			result = append(result, v)
+		}
+	case matrix.PolygonMatrix:
+		for _, v := range splitPolygon(m, b.linework()) {
+			result = append(result, v)
+		}
+	case matrix.MultiPolygonMatrix:
+		for _, poly := range m {
+			for _, v := range splitPolygon(poly, b.linework()) {
+				result = append(result, v)
+			}
+		}
+	case matrix.Collection:
+		for _, v := range m {
+			result = append(result, Split(v, blade)...)
+		}
+	default:
+		result = append(result, input)
+	}
+	return result
+}
+
+// splitPolygon returns the faces of the rings of the polygon noded with the blade, which are inside the polygon.
+func splitPolygon(poly matrix.PolygonMatrix, blade []matrix.LineMatrix) []matrix.PolygonMatrix {
+	c := &components{}
+	c.add(poly)
+	if len(c.polys) == 0 {
+		return nil
+	}
+	poly = c.polys[0]
+	noded := matrix.Collection{}
+	for _, v := range graph.NodeLines(append(c.linework(), blade...)) {
+		noded = append(noded, v)
+	}
+	pieces := []matrix.PolygonMatrix{}
+	for _, v := range Polygonize(noded).Polygons {
+		holes := []matrix.LineMatrix{}
+		for _, hole := range v[1:] {
+			holes = append(holes, hole)
+		}
+		if graph.InsidePolygon(graph.InteriorPoint(v[0], holes), poly) {
+			pieces = append(pieces, v)
+		}
+	}
+	return pieces
+}
@@ -0,0 +1,95 @@
+package overlay
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+func TestSplit_Line(t *testing.T) {
+	line := matrix.LineMatrix{{0, 0}, {10, 0}, {10, 10}}
+	tests := []struct {
+		name  string
+		blade matrix.Steric
+		want  []matrix.LineMatrix
+	}{
+		{name: "point", blade: matrix.Matrix{5, 0},
+			want: []matrix.LineMatrix{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}, {10, 10}}}},
+		{name: "points", blade: matrix.Collection{matrix.Matrix{10, 0}, matrix.Matrix{10, 5}, matrix.Matrix{20, 20}},
+			want: []matrix.LineMatrix{{{0, 0}, {10, 0}}, {{10, 0}, {10, 5}}, {{10, 5}, {10, 10}}}},
+		{name: "line", blade: matrix.LineMatrix{{5, -5}, {5, 5}, {15, 5}},
+			want: []matrix.LineMatrix{{{0, 0}, {5, 0}}, {{5, 0}, {10, 0}, {10, 5}}, {{10, 5}, {10, 10}}}},
+		{name: "disjoint", blade: matrix.LineMatrix{{20, 0}, {20, 10}},
+			want: []matrix.LineMatrix{line}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(line, tt.blade)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Split() = %v, want %v", got, tt.want)
+			}
+			for i, v := range got {
+				if !v.Equals(tt.want[i]) {
+					t.Errorf("Split() piece %v = %v, want %v", i, v, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestSplit_Polygon(t *testing.T) {
+	square := matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	tests := []struct {
+		name  string
+		input matrix.Steric
+		blade matrix.Steric
+		areas []float64
+		holes []int
+	}{
+		{name: "cross", input: square, blade: matrix.LineMatrix{{4, -5}, {4, 15}},
+			areas: []float64{40, 60}, holes: []int{0, 0}},
+		{name: "partial", input: square, blade: matrix.LineMatrix{{4, -5}, {4, 5}},
+			areas: []float64{100}, holes: []int{0}},
+		{name: "hole", input: matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{6, 6}, {6, 8}, {8, 8}, {8, 6}, {6, 6}},
+		}, blade: matrix.LineMatrix{{4, -5}, {4, 15}}, areas: []float64{40, 56}, holes: []int{0, 1}},
+		{name: "through hole", input: matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{3, 3}, {3, 5}, {5, 5}, {5, 3}, {3, 3}},
+		}, blade: matrix.LineMatrix{{4, -5}, {4, 15}}, areas: []float64{38, 58}, holes: []int{0, 0}},
+		{name: "multi polygon", input: matrix.MultiPolygonMatrix{
+			square,
+			{{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}},
+		}, blade: matrix.LineMatrix{{-5, 5}, {35, 5}}, areas: []float64{50, 50, 50, 50}, holes: []int{0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.input, tt.blade)
+			if len(got) != len(tt.areas) {
+				t.Fatalf("Split() = %v pieces, want %v", len(got), len(tt.areas))
+			}
+			areas := map[float64]int{}
+			holes := map[int]int{}
+			for _, v := range got {
+				poly := v.(matrix.PolygonMatrix)
+				area := math.Abs(measure.AreaDirection(poly[0]))
+				for _, hole := range poly[1:] {
+					area -= math.Abs(measure.AreaDirection(hole))
+				}
+				areas[math.Round(area*1e6)/1e6]++
+				holes[len(poly)-1]++
+			}
+			for i, area := range tt.areas {
+				if areas[area]--; areas[area] < 0 {
+					t.Errorf("Split() has no piece of area %v", area)
+				}
+				if holes[tt.holes[i]]--; holes[tt.holes[i]] < 0 {
+					t.Errorf("Split() has no piece with %v holes", tt.holes[i])
+				}
+			}
+		})
+	}
+}
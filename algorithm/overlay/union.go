@@ -1,16 +1,62 @@
 package overlay
 
 import (
+	"github.com/spatial-go/geoos/algorithm/graph"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 )
 
-// UnaryUnion returns a Geometry containing the union.
-//	or an empty atomic geometry, or an empty GEOMETRYCOLLECTION
+// UnaryUnion returns a Geometry containing the union of the elements of a steric of any type,
+// or an empty atomic geometry, or an empty GEOMETRYCOLLECTION.
+// The polygons are unioned by CascadedUnion, then the lines and points which are not covered are added.
 func UnaryUnion(matrix4 matrix.Steric) matrix.Steric {
-	if c, ok := matrix4.(matrix.Collection); ok {
-		return UnaryUnionByHalf(c, 0, len(c))
+	c := &components{}
+	c.add(matrix4)
+	if c.dimension() < 0 {
+		return matrix.Collection{}
 	}
-	return nil
+	polys := CascadedUnion(c.polys)
+	if len(c.lines) == 0 && len(c.points) == 0 {
+		return polys
+	}
+	lines := graph.Node(c.lines)
+	if len(c.polys) == 0 {
+		return unionLinework(lines, c.points)
+	}
+	others := matrix.Collection{}
+	for _, v := range lines {
+		others = append(others, v)
+	}
+	for _, v := range c.points {
+		others = append(others, v)
+	}
+	return NodedOverlay(polys, others, OpUnion)
+}
+
+// Node returns the linework of the steric, the lines and the rings of the polygons, fully noded. See graph.Node.
+func Node(steric matrix.Steric) []matrix.LineMatrix {
+	c := &components{}
+	c.add(steric)
+	return graph.Node(c.linework())
+}
+
+// unionLinework returns the union of the noded lines and the points, the points on the lines are dropped.
+func unionLinework(lines []matrix.LineMatrix, points []matrix.Matrix) matrix.Steric {
+	linework := &components{lines: lines}
+	result := matrix.Collection{}
+	for _, v := range lines {
+		result = append(result, v)
+	}
+	kept := []matrix.Matrix{}
+	for _, v := range points {
+		if !linework.covers(v) && !graph.ContainsPoint(kept, v) {
+			kept = append(kept, v)
+			result = append(result, v)
+		}
+	}
+	if len(result) == 1 {
+		return result[0]
+	}
+	return result
 }
 
 // UnaryUnionByHalf returns Unions a section of a list using a recursive binary union on each half of the section.
@@ -0,0 +1,243 @@
+package triangulate
+
+import (
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// InsertConstraint Inserts the segment between the points of the indexes u and v as an edge of the triangulation,
+// the edges it crosses are flipped away and the Delaunay condition is restored for the new edges, see Sloan 1993.
+// A segment through other points is inserted as the segments between them.
+// A segment which crosses a constraint inserted before is not inserted and false is returned.
+func (t *Triangulation) InsertConstraint(u, v int) bool {
+	t.initConstraints()
+	if u == v {
+		return true
+	}
+	pu, pv := t.Points[u], t.Points[v]
+	between := []int{}
+	for i, p := range t.Points {
+		if i != u && i != v && orientation(pu, pv, p) == 0 && inInterior(p, pu, pv) {
+			between = append(between, i)
+		}
+	}
+	if len(between) > 0 {
+		sort.Slice(between, func(i, j int) bool {
+			return graph.SquareDistance(pu, t.Points[between[i]]) < graph.SquareDistance(pu, t.Points[between[j]])
+		})
+		inserted, from := true, u
+		for _, w := range append(between, v) {
+			inserted = t.InsertConstraint(from, w) && inserted
+			from = w
+		}
+		return inserted
+	}
+	if t.hasEdge(u, v) {
+		t.fixed[edgeKey(u, v)] = true
+		return true
+	}
+
+	crossing := [][2]int{}
+	for e, a := range t.Triangles {
+		b := t.Triangles[next(e)]
+		if a > b && t.Halfedges[e] != -1 {
+			continue
+		}
+		if crosses(pu, pv, t.Points[a], t.Points[b]) {
+			if t.fixed[edgeKey(a, b)] {
+				return false
+			}
+			crossing = append(crossing, [2]int{a, b})
+		}
+	}
+
+	// flip the crossing edges, an edge of a quadrilateral which is not convex is tried again later
+	newEdges := [][2]int{}
+	for skipped := 0; len(crossing) > 0 && skipped <= len(crossing); {
+		edge := crossing[0]
+		crossing = crossing[1:]
+		e := t.edges[edge]
+		p0, p1 := t.Triangles[prev(e)], t.Triangles[prev(t.Halfedges[e])]
+		if !crosses(t.Points[p0], t.Points[p1], t.Points[edge[0]], t.Points[edge[1]]) {
+			crossing = append(crossing, edge)
+			skipped++
+			continue
+		}
+		skipped = 0
+		t.flip(e)
+		if crosses(pu, pv, t.Points[p0], t.Points[p1]) {
+			crossing = append(crossing, [2]int{p0, p1})
+		} else {
+			newEdges = append(newEdges, [2]int{p0, p1})
+		}
+	}
+	if len(crossing) > 0 {
+		return false
+	}
+
+	// restore the Delaunay condition of the new edges, except the constraint
+	t.fixed[edgeKey(u, v)] = true
+	for swapped := true; swapped; {
+		swapped = false
+		for i, edge := range newEdges {
+			e, ok := t.edges[edge]
+			if !ok || t.fixed[edgeKey(edge[0], edge[1])] || t.Halfedges[e] == -1 {
+				continue
+			}
+			p0, pr, pl, p1 := t.Triangles[prev(e)], t.Triangles[e], t.Triangles[next(e)], t.Triangles[prev(t.Halfedges[e])]
+			if inCircle(t.Points[p0], t.Points[pr], t.Points[pl], t.Points[p1]) < 0 &&
+				crosses(t.Points[p0], t.Points[p1], t.Points[pr], t.Points[pl]) {
+				t.flip(e)
+				newEdges[i] = [2]int{p0, p1}
+				swapped = true
+			}
+		}
+	}
+	return true
+}
+
+// IsConstraint returns true if the edge between the points of the indexes is a constraint.
+func (t *Triangulation) IsConstraint(u, v int) bool {
+	return t.fixed[edgeKey(u, v)]
+}
+
+// initConstraints indexes the half-edges by their points.
+func (t *Triangulation) initConstraints() {
+	if t.edges != nil {
+		return
+	}
+	t.edges = make(map[[2]int]int, len(t.Triangles))
+	t.fixed = map[[2]int]bool{}
+	for e, v := range t.Triangles {
+		t.edges[[2]int{v, t.Triangles[next(e)]}] = e
+	}
+}
+
+func (t *Triangulation) hasEdge(u, v int) bool {
+	_, uv := t.edges[[2]int{u, v}]
+	_, vu := t.edges[[2]int{v, u}]
+	return uv || vu
+}
+
+func edgeKey(u, v int) [2]int {
+	if u > v {
+		return [2]int{v, u}
+	}
+	return [2]int{u, v}
+}
+
+// crosses returns true if the segments ab and cd cross at a point interior to both.
+func crosses(a, b, c, d matrix.Matrix) bool {
+	return orientation(a, b, c)*orientation(a, b, d) < 0 && orientation(c, d, a)*orientation(c, d, b) < 0
+}
+
+// inInterior returns true if the point p of the line of the segment ab is between a and b.
+func inInterior(p, a, b matrix.Matrix) bool {
+	dot := (p[0]-a[0])*(b[0]-a[0]) + (p[1]-a[1])*(b[1]-a[1])
+	return dot > 0 && dot < graph.SquareDistance(a, b)
+}
+
+// DelaunayTriangles returns the triangles of the Delaunay triangulation of the vertices of the geometry,
+// vertices closer than the tolerance are merged.
+func DelaunayTriangles(m matrix.Steric, tolerance float64) []matrix.PolygonMatrix {
+	return NewDelaunay(vertices(m, nil), tolerance).TrianglePolygons()
+}
+
+// DelaunayEdges returns the edges of the Delaunay triangulation of the vertices of the geometry,
+// vertices closer than the tolerance are merged.
+func DelaunayEdges(m matrix.Steric, tolerance float64) []matrix.LineMatrix {
+	return NewDelaunay(vertices(m, nil), tolerance).Edges()
+}
+
+// ConstrainedDelaunay Computes the constrained Delaunay triangulation of the vertices of the geometry,
+// the segments of its lines and polygon rings are the constraints.
+// It returns the triangulation and false if some segments cross and are not all inserted.
+func ConstrainedDelaunay(m matrix.Steric) (*Triangulation, bool) {
+	t := NewDelaunay(vertices(m, nil), 0)
+	t.initConstraints()
+	index := make(map[[2]float64]int, len(t.Points))
+	for i, p := range t.Points {
+		index[[2]float64{p[0], p[1]}] = i
+	}
+	inserted := true
+	for _, line := range linework(m, nil) {
+		for i := 0; i < len(line)-1; i++ {
+			u, v := index[[2]float64{line[i][0], line[i][1]}], index[[2]float64{line[i+1][0], line[i+1][1]}]
+			inserted = t.InsertConstraint(u, v) && inserted
+		}
+	}
+	return t, inserted
+}
+
+// ConstrainedDelaunayTriangles returns the triangles of the constrained Delaunay triangulation of the geometry,
+// for a geometry with polygons only the triangles inside the polygons and outside their holes.
+func ConstrainedDelaunayTriangles(m matrix.Steric) []matrix.PolygonMatrix {
+	t, _ := ConstrainedDelaunay(m)
+	polys := polygons(m, nil)
+	result := []matrix.PolygonMatrix{}
+	for i := 0; i < t.NumTriangles(); i++ {
+		tri := t.trianglePolygon(i)
+		centroid := matrix.Matrix{(tri[0][0][0] + tri[0][1][0] + tri[0][2][0]) / 3, (tri[0][0][1] + tri[0][1][1] + tri[0][2][1]) / 3}
+		if len(polys) == 0 || graph.InsidePolygons(centroid, polys) {
+			result = append(result, tri)
+		}
+	}
+	return result
+}
+
+// vertices appends the vertices of the geometry to the points.
+func vertices(m matrix.Steric, points []matrix.Matrix) []matrix.Matrix {
+	switch st := m.(type) {
+	case matrix.Matrix:
+		points = append(points, st)
+	case matrix.LineMatrix:
+		for _, v := range st {
+			points = append(points, v)
+		}
+	case matrix.PolygonMatrix:
+		for _, ring := range st {
+			for _, v := range ring {
+				points = append(points, v)
+			}
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			points = vertices(v, points)
+		}
+	}
+	return points
+}
+
+// linework appends the lines and polygon rings of the geometry to the lines.
+func linework(m matrix.Steric, lines []matrix.LineMatrix) []matrix.LineMatrix {
+	switch st := m.(type) {
+	case matrix.LineMatrix:
+		lines = append(lines, st)
+	case matrix.PolygonMatrix:
+		for _, ring := range st {
+			lines = append(lines, ring)
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			lines = linework(v, lines)
+		}
+	}
+	return lines
+}
+
+// polygons appends the polygons of the geometry to the polygons.
+func polygons(m matrix.Steric, polys []matrix.PolygonMatrix) []matrix.PolygonMatrix {
+	switch st := m.(type) {
+	case matrix.PolygonMatrix:
+		if len(st) > 0 {
+			polys = append(polys, st)
+		}
+	case matrix.Collection:
+		for _, v := range st {
+			polys = polygons(v, polys)
+		}
+	}
+	return polys
+}
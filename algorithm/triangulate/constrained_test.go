@@ -0,0 +1,105 @@
+package triangulate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+func TestConstrainedDelaunayTriangles(t *testing.T) {
+	circle := matrix.LineMatrix{}
+	for i := 0; i < 200; i++ {
+		a := 2 * math.Pi * float64(i) / 200
+		circle = append(circle, []float64{10 * math.Cos(a), 10 * math.Sin(a)})
+	}
+	circle = append(circle, circle[0])
+	tests := []struct {
+		name string
+		geom matrix.Steric
+		want int
+	}{
+		{name: "concave polygon", geom: matrix.PolygonMatrix{{{0, 0}, {10, 0}, {10, 10}, {5, 1}, {0, 10}, {0, 0}}}, want: 3},
+		{name: "annulus", geom: matrix.PolygonMatrix{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{3, 3}, {3, 7}, {7, 7}, {7, 3}, {3, 3}},
+		}, want: 8},
+		{name: "circle", geom: matrix.PolygonMatrix{circle}, want: 198},
+		{name: "line and points", geom: matrix.Collection{
+			matrix.LineMatrix{{0, 0}, {10, 0}},
+			matrix.Matrix{5, 1}, matrix.Matrix{5, -1},
+		}, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ConstrainedDelaunayTriangles(tt.geom)
+			if len(got) != tt.want {
+				t.Errorf("ConstrainedDelaunayTriangles() = %v triangles, want %v", len(got), tt.want)
+			}
+			area := 0.0
+			for _, v := range got {
+				tri := v[0]
+				area += ((tri[1][0]-tri[0][0])*(tri[2][1]-tri[0][1]) - (tri[2][0]-tri[0][0])*(tri[1][1]-tri[0][1])) / 2
+			}
+			if poly, ok := tt.geom.(matrix.PolygonMatrix); ok {
+				want := 0.0
+				for i, ring := range poly {
+					ringArea := 0.0
+					for j := 0; j < len(ring)-1; j++ {
+						ringArea += (ring[j][0]*ring[j+1][1] - ring[j+1][0]*ring[j][1]) / 2
+					}
+					if i == 0 {
+						want += math.Abs(ringArea)
+					} else {
+						want -= math.Abs(ringArea)
+					}
+				}
+				if math.Abs(area-want) > 1e-9 {
+					t.Errorf("ConstrainedDelaunayTriangles() area = %v, want %v", area, want)
+				}
+			}
+		})
+	}
+}
+
+func TestInsertConstraint(t *testing.T) {
+	points := []matrix.Matrix{{0, 0}, {10, 0}, {10, 10}, {0, 10}}
+	for i := 0; i < 9; i++ {
+		points = append(points, matrix.Matrix{float64(i) + 0.5, 4 + 0.2*float64(i%2)}, matrix.Matrix{float64(i) + 0.5, 6 - 0.2*float64(i%2)})
+	}
+	tr := NewDelaunay(points, 0)
+	tr.initConstraints()
+	a, b, c, d := indexOf(tr, 0, 0), indexOf(tr, 10, 0), indexOf(tr, 10, 10), indexOf(tr, 0, 10)
+	if tr.hasEdge(a, c) {
+		t.Fatalf("edge of the diagonal is already in the triangulation")
+	}
+	if !tr.InsertConstraint(a, c) {
+		t.Fatalf("InsertConstraint() = false, want true")
+	}
+	if !tr.hasEdge(a, c) || !tr.IsConstraint(c, a) {
+		t.Errorf("InsertConstraint() edge is not a constraint")
+	}
+	checkTriangulation(t, tr, false)
+	if tr.InsertConstraint(b, d) {
+		t.Errorf("InsertConstraint() crossing a constraint = true, want false")
+	}
+	if !tr.hasEdge(a, c) {
+		t.Errorf("InsertConstraint() constraint lost")
+	}
+
+	// the constraint through the middle point is inserted as two edges
+	tr = NewDelaunay([]matrix.Matrix{{0, 0}, {2, 2}, {4, 4}, {4, 0}, {0, 4}}, 0)
+	a, b, c = indexOf(tr, 0, 0), indexOf(tr, 2, 2), indexOf(tr, 4, 4)
+	if !tr.InsertConstraint(a, c) || !tr.IsConstraint(a, b) || !tr.IsConstraint(b, c) {
+		t.Errorf("InsertConstraint() through a point is not split")
+	}
+}
+
+func indexOf(tr *Triangulation, x, y float64) int {
+	for i, p := range tr.Points {
+		if p[0] == x && p[1] == y {
+			return i
+		}
+	}
+	return -1
+}
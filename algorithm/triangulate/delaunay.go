@@ -0,0 +1,385 @@
+// Package triangulate provides the Delaunay triangulation, the constrained Delaunay triangulation and the Voronoi diagram of the vertices of geometries.
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// Triangulation A triangulation of a set of points, stored as half-edges.
+// The half-edge e runs from Points[Triangles[e]] to Points[Triangles[next(e)]],
+// the three half-edges of a triangle are 3t, 3t+1 and 3t+2, in clockwise order,
+// and Halfedges[e] is the opposite half-edge of the adjacent triangle, -1 on the convex hull.
+type Triangulation struct {
+	Points    []matrix.Matrix
+	Triangles []int
+	Halfedges []int
+
+	// hull of the sweep, indexed by point.
+	hullPrev, hullNext, hullTri, hullHash []int
+	hullStart                             int
+	center                                matrix.Matrix
+
+	// constraints of the triangulation, see InsertConstraint.
+	edges map[[2]int]int
+	fixed map[[2]int]bool
+}
+
+// NewDelaunay Computes the Delaunay triangulation of the points with the sweep-hull algorithm.
+// Points closer to a previous point than the tolerance are merged with it, exact duplicates are always merged.
+// Points which are all collinear have no triangles.
+func NewDelaunay(points []matrix.Matrix, tolerance float64) *Triangulation {
+	t := &Triangulation{Points: uniquePoints(points, tolerance)}
+	t.build()
+	return t
+}
+
+// NumTriangles returns the number of triangles.
+func (t *Triangulation) NumTriangles() int {
+	return len(t.Triangles) / 3
+}
+
+// Triangle returns the indexes of the points of the i-th triangle, in counter clockwise order.
+func (t *Triangulation) Triangle(i int) [3]int {
+	return [3]int{t.Triangles[3*i], t.Triangles[3*i+2], t.Triangles[3*i+1]}
+}
+
+// TrianglePolygons returns the triangles as polygons, with counter clockwise shells.
+func (t *Triangulation) TrianglePolygons() []matrix.PolygonMatrix {
+	polys := make([]matrix.PolygonMatrix, 0, t.NumTriangles())
+	for i := 0; i < t.NumTriangles(); i++ {
+		polys = append(polys, t.trianglePolygon(i))
+	}
+	return polys
+}
+
+func (t *Triangulation) trianglePolygon(i int) matrix.PolygonMatrix {
+	tri := t.Triangle(i)
+	a, b, c := t.Points[tri[0]], t.Points[tri[1]], t.Points[tri[2]]
+	return matrix.PolygonMatrix{{{a[0], a[1]}, {b[0], b[1]}, {c[0], c[1]}, {a[0], a[1]}}}
+}
+
+// Edges returns the edges of the triangulation, each edge once.
+func (t *Triangulation) Edges() []matrix.LineMatrix {
+	lines := []matrix.LineMatrix{}
+	for e, v := range t.Triangles {
+		w := t.Triangles[next(e)]
+		if v < w || t.Halfedges[e] == -1 {
+			p, q := t.Points[v], t.Points[w]
+			lines = append(lines, matrix.LineMatrix{{p[0], p[1]}, {q[0], q[1]}})
+		}
+	}
+	return lines
+}
+
+// next returns the next half-edge of the triangle.
+func next(e int) int {
+	if e%3 == 2 {
+		return e - 2
+	}
+	return e + 1
+}
+
+// prev returns the previous half-edge of the triangle.
+func prev(e int) int {
+	if e%3 == 0 {
+		return e + 2
+	}
+	return e - 1
+}
+
+// uniquePoints returns the points without duplicates,
+// a point closer than the tolerance to a kept point is dropped.
+func uniquePoints(points []matrix.Matrix, tolerance float64) []matrix.Matrix {
+	sorted := make([]matrix.Matrix, 0, len(points))
+	for _, v := range points {
+		sorted = append(sorted, matrix.Matrix{v[0], v[1]})
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0] || (sorted[i][0] == sorted[j][0] && sorted[i][1] < sorted[j][1])
+	})
+	result := []matrix.Matrix{}
+	for _, v := range sorted {
+		duplicate := false
+		for k := len(result) - 1; k >= 0 && v[0]-result[k][0] <= tolerance; k-- {
+			dx, dy := v[0]-result[k][0], v[1]-result[k][1]
+			if dx*dx+dy*dy <= tolerance*tolerance {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// build triangulates the points by sweeping them in the order of their distance to the circumcenter of a seed triangle,
+// adding each point to the hull of the points before it, and flipping the new triangles to restore the Delaunay condition.
+func (t *Triangulation) build() {
+	n := len(t.Points)
+	t.Triangles, t.Halfedges = []int{}, []int{}
+	if n < 3 {
+		return
+	}
+	minX, minY, maxX, maxY := math.Inf(1), math.Inf(1), math.Inf(-1), math.Inf(-1)
+	for _, p := range t.Points {
+		minX, minY = math.Min(minX, p[0]), math.Min(minY, p[1])
+		maxX, maxY = math.Max(maxX, p[0]), math.Max(maxY, p[1])
+	}
+	c := matrix.Matrix{(minX + maxX) / 2, (minY + maxY) / 2}
+
+	// seed triangle, the point closest to the center, the point closest to it,
+	// and the point which makes the smallest circumcircle with them.
+	i0, i1, i2 := -1, -1, -1
+	minDist := math.Inf(1)
+	for i, p := range t.Points {
+		if d := graph.SquareDistance(c, p); d < minDist {
+			i0, minDist = i, d
+		}
+	}
+	minDist = math.Inf(1)
+	for i, p := range t.Points {
+		if d := graph.SquareDistance(t.Points[i0], p); i != i0 && d < minDist {
+			i1, minDist = i, d
+		}
+	}
+	minRadius := math.Inf(1)
+	for i, p := range t.Points {
+		if i == i0 || i == i1 {
+			continue
+		}
+		if r := circumradius(t.Points[i0], t.Points[i1], p); r < minRadius {
+			i2, minRadius = i, r
+		}
+	}
+	if i2 < 0 || orientation(t.Points[i0], t.Points[i1], t.Points[i2]) == 0 {
+		// all points are collinear
+		return
+	}
+	if orientation(t.Points[i0], t.Points[i1], t.Points[i2]) > 0 {
+		i1, i2 = i2, i1
+	}
+	t.center = circumcenter(t.Points[i0], t.Points[i1], t.Points[i2])
+
+	ids := make([]int, n)
+	dists := make([]float64, n)
+	for i := range ids {
+		ids[i] = i
+		dists[i] = graph.SquareDistance(t.Points[i], t.center)
+	}
+	sort.SliceStable(ids, func(i, j int) bool { return dists[ids[i]] < dists[ids[j]] })
+
+	hashSize := int(math.Ceil(math.Sqrt(float64(n))))
+	t.hullPrev, t.hullNext, t.hullTri = make([]int, n), make([]int, n), make([]int, n)
+	t.hullHash = make([]int, hashSize)
+	for i := range t.hullHash {
+		t.hullHash[i] = -1
+	}
+	t.hullStart = i0
+	t.hullNext[i0], t.hullPrev[i2] = i1, i1
+	t.hullNext[i1], t.hullPrev[i0] = i2, i2
+	t.hullNext[i2], t.hullPrev[i1] = i0, i0
+	t.hullTri[i0], t.hullTri[i1], t.hullTri[i2] = 0, 1, 2
+	t.hullHash[t.hashKey(t.Points[i0])] = i0
+	t.hullHash[t.hashKey(t.Points[i1])] = i1
+	t.hullHash[t.hashKey(t.Points[i2])] = i2
+	t.addTriangle(i0, i1, i2, -1, -1, -1)
+
+	for _, i := range ids {
+		if i == i0 || i == i1 || i == i2 {
+			continue
+		}
+		p := t.Points[i]
+
+		// find a visible edge on the hull using the hash
+		start := 0
+		for j, key := 0, t.hashKey(p); j < hashSize; j++ {
+			start = t.hullHash[(key+j)%hashSize]
+			if start != -1 && start != t.hullNext[start] {
+				break
+			}
+		}
+		start = t.hullPrev[start]
+		e, q := start, t.hullNext[start]
+		for orientation(p, t.Points[e], t.Points[q]) <= 0 {
+			e = q
+			if e == start {
+				e = -1
+				break
+			}
+			q = t.hullNext[e]
+		}
+		if e == -1 {
+			// no hull edge is visible from the point, which only happens for degenerate input
+			continue
+		}
+
+		// add the first triangle from the point
+		tri := t.addTriangle(e, i, t.hullNext[e], -1, -1, t.hullTri[e])
+		t.hullTri[i] = t.legalize(tri + 2)
+		t.hullTri[e] = tri
+
+		// walk forward through the hull, adding more triangles and flipping
+		nx := t.hullNext[e]
+		for q = t.hullNext[nx]; orientation(p, t.Points[nx], t.Points[q]) > 0; q = t.hullNext[nx] {
+			tri = t.addTriangle(nx, i, q, t.hullTri[i], -1, t.hullTri[nx])
+			t.hullTri[i] = t.legalize(tri + 2)
+			t.hullNext[nx] = nx // removed from the hull
+			nx = q
+		}
+
+		// walk backward from the other side, adding more triangles and flipping
+		if e == start {
+			for q = t.hullPrev[e]; orientation(p, t.Points[q], t.Points[e]) > 0; q = t.hullPrev[e] {
+				tri = t.addTriangle(q, i, e, -1, t.hullTri[e], t.hullTri[q])
+				t.legalize(tri + 2)
+				t.hullTri[q] = tri
+				t.hullNext[e] = e // removed from the hull
+				e = q
+			}
+		}
+
+		// update the hull
+		t.hullStart = e
+		t.hullPrev[i] = e
+		t.hullNext[e] = i
+		t.hullPrev[nx] = i
+		t.hullNext[i] = nx
+
+		t.hullHash[t.hashKey(p)] = i
+		t.hullHash[t.hashKey(t.Points[e])] = e
+	}
+}
+
+// hashKey returns the hash of the angle of the point around the center.
+func (t *Triangulation) hashKey(p matrix.Matrix) int {
+	dx, dy := p[0]-t.center[0], p[1]-t.center[1]
+	if dx == 0 && dy == 0 {
+		return 0
+	}
+	pseudoAngle := dx / (math.Abs(dx) + math.Abs(dy))
+	if dy > 0 {
+		pseudoAngle = 3 - pseudoAngle
+	} else {
+		pseudoAngle = 1 + pseudoAngle
+	}
+	size := len(t.hullHash)
+	return int(math.Floor(pseudoAngle/4*float64(size))) % size
+}
+
+// addTriangle adds the triangle of the points i0, i1, i2 with the half-edges opposite to its edges,
+// and returns its first half-edge.
+func (t *Triangulation) addTriangle(i0, i1, i2, a, b, c int) int {
+	e := len(t.Triangles)
+	t.Triangles = append(t.Triangles, i0, i1, i2)
+	t.Halfedges = append(t.Halfedges, -1, -1, -1)
+	t.link(e, a)
+	t.link(e+1, b)
+	t.link(e+2, c)
+	return e
+}
+
+func (t *Triangulation) link(a, b int) {
+	t.Halfedges[a] = b
+	if b != -1 {
+		t.Halfedges[b] = a
+	}
+}
+
+// legalize flips the half-edge and the edges behind it until the triangles satisfy the Delaunay condition,
+// and returns the half-edge which ends at the start of the half-edge.
+func (t *Triangulation) legalize(a int) int {
+	stack := []int{}
+	ar := 0
+	for {
+		b := t.Halfedges[a]
+		ar = prev(a)
+		if b == -1 {
+			if len(stack) == 0 {
+				break
+			}
+			a, stack = stack[len(stack)-1], stack[:len(stack)-1]
+			continue
+		}
+		al, bl := next(a), prev(b)
+		p0, pr, pl, p1 := t.Triangles[ar], t.Triangles[a], t.Triangles[al], t.Triangles[bl]
+		if inCircle(t.Points[p0], t.Points[pr], t.Points[pl], t.Points[p1]) < 0 {
+			hbl := t.Halfedges[bl]
+			if hbl == -1 {
+				// the edge swapped on the other side of the hull, fix the hull triangle
+				for e := t.hullStart; ; {
+					if t.hullTri[e] == bl {
+						t.hullTri[e] = a
+						break
+					}
+					if e = t.hullPrev[e]; e == t.hullStart {
+						break
+					}
+				}
+			}
+			t.flip(a)
+			stack = append(stack, next(b))
+			continue
+		}
+		if len(stack) == 0 {
+			break
+		}
+		a, stack = stack[len(stack)-1], stack[:len(stack)-1]
+	}
+	return ar
+}
+
+// flip replaces the edge of the half-edge a by the other diagonal of the quadrilateral of its two triangles,
+// the new diagonal is the half-edges prev(a) and prev(Halfedges[a]).
+func (t *Triangulation) flip(a int) {
+	b := t.Halfedges[a]
+	ar, al, bl := prev(a), next(a), prev(b)
+	p0, pr, pl, p1 := t.Triangles[ar], t.Triangles[a], t.Triangles[al], t.Triangles[bl]
+	hbl, har := t.Halfedges[bl], t.Halfedges[ar]
+
+	t.Triangles[a] = p1
+	t.Triangles[b] = p0
+	t.link(a, hbl)
+	t.link(b, har)
+	t.link(ar, bl)
+
+	if t.edges != nil {
+		delete(t.edges, [2]int{pr, pl})
+		delete(t.edges, [2]int{pl, pr})
+		t.edges[[2]int{p1, pl}] = a
+		t.edges[[2]int{p0, pr}] = b
+		t.edges[[2]int{p0, p1}] = ar
+		t.edges[[2]int{p1, p0}] = bl
+	}
+}
+
+// circumradius returns the square of the radius of the circumcircle of the triangle, +Inf if it is degenerate.
+func circumradius(a, b, c matrix.Matrix) float64 {
+	center := circumcenter(a, b, c)
+	if center == nil {
+		return math.Inf(1)
+	}
+	return graph.SquareDistance(center, a)
+}
+
+// circumcenter returns the center of the circumcircle of the triangle, nil if it is degenerate.
+func circumcenter(a, b, c matrix.Matrix) matrix.Matrix {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+	ex, ey := c[0]-a[0], c[1]-a[1]
+	bl, cl := dx*dx+dy*dy, ex*ex+ey*ey
+	d := dx*ey - dy*ex
+	if d == 0 {
+		return nil
+	}
+	d = 0.5 / d
+	x, y := (ey*bl-dy*cl)*d, (dx*cl-ex*bl)*d
+	if math.IsInf(x, 0) || math.IsInf(y, 0) || math.IsNaN(x) || math.IsNaN(y) {
+		return nil
+	}
+	return matrix.Matrix{a[0] + x, a[1] + y}
+}
@@ -0,0 +1,98 @@
+package triangulate
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// checkTriangulation checks the half-edges are consistent and, if delaunay is set,
+// that no point lies inside the circumcircle of a triangle.
+func checkTriangulation(t *testing.T, tr *Triangulation, delaunay bool) {
+	t.Helper()
+	for e, h := range tr.Halfedges {
+		if h != -1 && tr.Halfedges[h] != e {
+			t.Fatalf("half-edge %d opposite %d is not linked back", e, h)
+		}
+		if h != -1 && (tr.Triangles[e] != tr.Triangles[next(h)] || tr.Triangles[next(e)] != tr.Triangles[h]) {
+			t.Fatalf("half-edge %d and %d do not share points", e, h)
+		}
+	}
+	for i := 0; i < tr.NumTriangles(); i++ {
+		tri := tr.Triangle(i)
+		a, b, c := tr.Points[tri[0]], tr.Points[tri[1]], tr.Points[tri[2]]
+		if orientation(a, b, c) <= 0 {
+			t.Fatalf("triangle %d %v is not counter clockwise", i, tri)
+		}
+		if !delaunay {
+			continue
+		}
+		for j, p := range tr.Points {
+			if j != tri[0] && j != tri[1] && j != tri[2] && inCircle(a, b, c, p) > 0 {
+				t.Fatalf("point %d is inside the circumcircle of triangle %d", j, i)
+			}
+		}
+	}
+}
+
+func TestNewDelaunay(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	random := []matrix.Matrix{}
+	for i := 0; i < 300; i++ {
+		random = append(random, matrix.Matrix{r.Float64() * 100, r.Float64() * 100})
+	}
+	grid := []matrix.Matrix{}
+	for i := 0; i < 20; i++ {
+		for j := 0; j < 20; j++ {
+			grid = append(grid, matrix.Matrix{float64(i), float64(j)}, matrix.Matrix{float64(i), float64(j)})
+		}
+	}
+	tests := []struct {
+		name      string
+		points    []matrix.Matrix
+		tolerance float64
+		want      int
+	}{
+		{name: "triangle", points: []matrix.Matrix{{0, 0}, {1, 0}, {0, 1}}, want: 1},
+		{name: "square", points: []matrix.Matrix{{0, 0}, {1, 0}, {1, 1}, {0, 1}}, want: 2},
+		{name: "square center", points: []matrix.Matrix{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}}, want: 4},
+		{name: "grid with duplicates", points: grid, want: 2 * 19 * 19},
+		{name: "tolerance", points: []matrix.Matrix{{0, 0}, {1, 0}, {0, 1}, {0.01, 0.01}}, tolerance: 0.1, want: 1},
+		{name: "collinear", points: []matrix.Matrix{{0, 0}, {1, 1}, {2, 2}, {3, 3}}, want: 0},
+		{name: "too few", points: []matrix.Matrix{{0, 0}, {1, 1}}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := NewDelaunay(tt.points, tt.tolerance)
+			if got := tr.NumTriangles(); got != tt.want {
+				t.Errorf("NumTriangles() = %v, want %v", got, tt.want)
+			}
+			checkTriangulation(t, tr, true)
+		})
+	}
+
+	tr := NewDelaunay(random, 0)
+	checkTriangulation(t, tr, true)
+	hull := 0
+	for _, h := range tr.Halfedges {
+		if h == -1 {
+			hull++
+		}
+	}
+	if got, want := tr.NumTriangles(), 2*len(random)-2-hull; got != want {
+		t.Errorf("NumTriangles() random = %v, want %v", got, want)
+	}
+}
+
+func TestDelaunayEdges(t *testing.T) {
+	got := DelaunayEdges(matrix.PolygonMatrix{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}, 0)
+	if len(got) != 5 {
+		t.Errorf("DelaunayEdges() = %v, want 5 edges", got)
+	}
+	tris := DelaunayTriangles(matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{4, 0}, matrix.Matrix{0, 4}}, 0)
+	want := matrix.PolygonMatrix{{{0, 0}, {4, 0}, {0, 4}, {0, 0}}}
+	if len(tris) != 1 || !tris[0].Equals(want) {
+		t.Errorf("DelaunayTriangles() = %v, want %v", tris, want)
+	}
+}
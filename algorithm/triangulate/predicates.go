@@ -0,0 +1,81 @@
+package triangulate
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/calc"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+)
+
+// orientation returns the orientation of r to the segment pq, 1 left, -1 right and 0 collinear.
+// The determinant is recomputed in double-double precision when it is too close to zero for float64.
+func orientation(p, q, r matrix.Matrix) int {
+	detLeft := (q[0] - p[0]) * (r[1] - p[1])
+	detRight := (q[1] - p[1]) * (r[0] - p[0])
+	det := detLeft - detRight
+	errBound := 1e-15 * (math.Abs(detLeft) + math.Abs(detRight))
+	if det > errBound {
+		return 1
+	}
+	if det < -errBound {
+		return -1
+	}
+
+	dx1 := calc.ValueOf(q[0]).SelfAddOne(-p[0])
+	dy1 := calc.ValueOf(q[1]).SelfAddOne(-p[1])
+	dx2 := calc.ValueOf(r[0]).SelfAddOne(-p[0])
+	dy2 := calc.ValueOf(r[1]).SelfAddOne(-p[1])
+	right := dy1.SelfMultiplyPair(dx2)
+	return dx1.SelfMultiplyPair(dy2).SelfSubtract(right.Hi, right.Lo).Signum()
+}
+
+// inCircle returns the sign of the in-circle determinant of p and the triangle abc,
+// positive if p is inside the circumcircle of a counter clockwise triangle, or outside of a clockwise triangle,
+// and 0 if p is on the circumcircle.
+// The determinant is recomputed in double-double precision when it is too close to zero for float64.
+func inCircle(a, b, c, p matrix.Matrix) int {
+	adx, ady := a[0]-p[0], a[1]-p[1]
+	bdx, bdy := b[0]-p[0], b[1]-p[1]
+	cdx, cdy := c[0]-p[0], c[1]-p[1]
+	alift := adx*adx + ady*ady
+	blift := bdx*bdx + bdy*bdy
+	clift := cdx*cdx + cdy*cdy
+	det := alift*(bdx*cdy-cdx*bdy) + blift*(cdx*ady-adx*cdy) + clift*(adx*bdy-bdx*ady)
+	permanent := alift*(math.Abs(bdx*cdy)+math.Abs(cdx*bdy)) +
+		blift*(math.Abs(cdx*ady)+math.Abs(adx*cdy)) +
+		clift*(math.Abs(adx*bdy)+math.Abs(bdx*ady))
+	errBound := 1e-14 * permanent
+	if det > errBound {
+		return 1
+	}
+	if det < -errBound {
+		return -1
+	}
+	return inCirclePair(a, b, c, p)
+}
+
+// inCirclePair computes the in-circle determinant in double-double precision.
+func inCirclePair(a, b, c, p matrix.Matrix) int {
+	diff := func(x, y float64) *calc.PairFloat { return calc.ValueOf(x).SelfAddOne(-y) }
+	adx, ady := diff(a[0], p[0]), diff(a[1], p[1])
+	bdx, bdy := diff(b[0], p[0]), diff(b[1], p[1])
+	cdx, cdy := diff(c[0], p[0]), diff(c[1], p[1])
+
+	lift := func(dx, dy *calc.PairFloat) *calc.PairFloat {
+		y2 := mul(dy, dy)
+		return mul(dx, dx).SelfAdd(y2.Hi, y2.Lo)
+	}
+	cross := func(x1, y1, x2, y2 *calc.PairFloat) *calc.PairFloat {
+		right := mul(x2, y1)
+		return mul(x1, y2).SelfSubtract(right.Hi, right.Lo)
+	}
+	aTerm := mul(lift(adx, ady), cross(bdx, bdy, cdx, cdy))
+	bTerm := mul(lift(bdx, bdy), cross(cdx, cdy, adx, ady))
+	cTerm := mul(lift(cdx, cdy), cross(adx, ady, bdx, bdy))
+	return aTerm.SelfAdd(bTerm.Hi, bTerm.Lo).SelfAdd(cTerm.Hi, cTerm.Lo).Signum()
+}
+
+// mul returns the product of the pair floats, without changing them.
+func mul(x, y *calc.PairFloat) *calc.PairFloat {
+	return (&calc.PairFloat{Hi: x.Hi, Lo: x.Lo}).SelfMultiplyPair(y)
+}
@@ -0,0 +1,199 @@
+package triangulate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+)
+
+// VoronoiCell A cell of the Voronoi diagram, the polygon of the points closer to its site than to the other sites.
+type VoronoiCell struct {
+	Polygon matrix.PolygonMatrix
+	Site    matrix.Matrix
+	// Indexes are the indexes of the vertices of the input geometry at the site, more than one if vertices were merged.
+	Indexes []int
+}
+
+// voronoiSide the side of a cell to the vertex, with the index of the site on its other side, -1 for the clip envelope.
+type voronoiSide struct {
+	vertex   matrix.Matrix
+	neighbor int
+}
+
+// VoronoiDiagram Computes the Voronoi diagram of the vertices of the geometry, clipped to the envelope.
+// Vertices closer than the tolerance are merged into one site.
+// If the clip envelope is nil, the envelope of the vertices expanded by its largest extent is used.
+// It returns the cells in the order of the first vertex of their site, the polygon of a cell outside the clip envelope is empty.
+func VoronoiDiagram(sites matrix.Steric, clip *envelope.Envelope, tolerance float64) []VoronoiCell {
+	cells, _ := voronoi(sites, clip, tolerance)
+	return cells
+}
+
+// VoronoiEdges returns the edges of the Voronoi diagram of the vertices of the geometry, clipped to the envelope,
+// the sides shared by two cells, without the sides on the clip envelope. See VoronoiDiagram.
+func VoronoiEdges(sites matrix.Steric, clip *envelope.Envelope, tolerance float64) []matrix.LineMatrix {
+	_, edges := voronoi(sites, clip, tolerance)
+	return edges
+}
+
+func voronoi(sites matrix.Steric, clip *envelope.Envelope, tolerance float64) ([]VoronoiCell, []matrix.LineMatrix) {
+	points := vertices(sites, nil)
+	if len(points) == 0 {
+		return nil, nil
+	}
+	t := NewDelaunay(points, tolerance)
+	if clip == nil || clip.IsNil() {
+		clip = envelope.Matrix(t.Points[0])
+		for _, p := range t.Points[1:] {
+			clip.ExpandToIncludeMatrix(p)
+		}
+		expand := math.Max(clip.Width(), clip.Height())
+		if expand == 0 {
+			expand = 1
+		}
+		clip.ExpandBy(expand)
+	}
+	scale := 1e-12 * math.Max(clip.Diameter(), math.SmallestNonzeroFloat64)
+
+	// the sites of the vertices, a merged vertex belongs to the nearest site
+	index := make(map[[2]float64]int, len(t.Points))
+	for i, p := range t.Points {
+		index[[2]float64{p[0], p[1]}] = i
+	}
+	indexes := make([][]int, len(t.Points))
+	first := make([]int, len(t.Points))
+	for i := range first {
+		first[i] = len(points)
+	}
+	for k, p := range points {
+		i, ok := index[[2]float64{p[0], p[1]}]
+		if !ok {
+			i = t.nearestPoint(p)
+		}
+		indexes[i] = append(indexes[i], k)
+		if k < first[i] {
+			first[i] = k
+		}
+	}
+
+	cells := make([]VoronoiCell, 0, len(t.Points))
+	edges := []matrix.LineMatrix{}
+	order := make([]int, len(t.Points))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(a, b int) bool { return first[order[a]] < first[order[b]] })
+	adjacency := t.adjacency()
+	for _, i := range order {
+		cell := []voronoiSide{
+			{matrix.Matrix{clip.MinX, clip.MinY}, -1},
+			{matrix.Matrix{clip.MaxX, clip.MinY}, -1},
+			{matrix.Matrix{clip.MaxX, clip.MaxY}, -1},
+			{matrix.Matrix{clip.MinX, clip.MaxY}, -1},
+		}
+		for _, j := range adjacency[i] {
+			cell = clipCell(cell, t.Points[i], t.Points[j], j, scale)
+		}
+		if len(cell) < 3 {
+			cells = append(cells, VoronoiCell{Polygon: matrix.PolygonMatrix{}, Site: t.Points[i], Indexes: indexes[i]})
+			continue
+		}
+		ring := make(matrix.LineMatrix, 0, len(cell)+1)
+		for k, v := range cell {
+			ring = append(ring, v.vertex)
+			if v.neighbor > i {
+				next := cell[(k+1)%len(cell)].vertex
+				edges = append(edges, matrix.LineMatrix{{v.vertex[0], v.vertex[1]}, {next[0], next[1]}})
+			}
+		}
+		ring = append(ring, cell[0].vertex)
+		cells = append(cells, VoronoiCell{
+			Polygon: matrix.PolygonMatrix{ring},
+			Site:    t.Points[i],
+			Indexes: indexes[i],
+		})
+	}
+	return cells, edges
+}
+
+// clipCell clips the convex cell of the site to the half plane of the points closer to the site than to the neighbor,
+// the side on the bisector is marked with the index of the neighbor.
+func clipCell(cell []voronoiSide, site, neighbor matrix.Matrix, j int, scale float64) []voronoiSide {
+	dx, dy := neighbor[0]-site[0], neighbor[1]-site[1]
+	mx, my := (site[0]+neighbor[0])/2, (site[1]+neighbor[1])/2
+	eps := scale * math.Hypot(dx, dy)
+	side := func(p matrix.Matrix) float64 {
+		d := (p[0]-mx)*dx + (p[1]-my)*dy
+		if math.Abs(d) <= eps {
+			return 0
+		}
+		return d
+	}
+	result := make([]voronoiSide, 0, len(cell)+1)
+	add := func(v voronoiSide) {
+		if len(result) > 0 && graph.SquareDistance(result[len(result)-1].vertex, v.vertex) <= scale*scale {
+			result[len(result)-1] = v
+			return
+		}
+		result = append(result, v)
+	}
+	for k, a := range cell {
+		b := cell[(k+1)%len(cell)]
+		da, db := side(a.vertex), side(b.vertex)
+		switch {
+		case da <= 0 && db <= 0:
+			add(a)
+		case da <= 0:
+			add(a)
+			add(voronoiSide{cutPoint(a.vertex, b.vertex, da, db), j})
+		case db <= 0:
+			add(voronoiSide{cutPoint(a.vertex, b.vertex, da, db), a.neighbor})
+		}
+	}
+	if len(result) > 1 && graph.SquareDistance(result[0].vertex, result[len(result)-1].vertex) <= scale*scale {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+// cutPoint returns the point of the segment ab where the side changes from da to db.
+func cutPoint(a, b matrix.Matrix, da, db float64) matrix.Matrix {
+	f := da / (da - db)
+	return matrix.Matrix{a[0] + f*(b[0]-a[0]), a[1] + f*(b[1]-a[1])}
+}
+
+// adjacency returns the indexes of the points joined to each point by an edge of the triangulation,
+// the previous and next point if all points are collinear.
+func (t *Triangulation) adjacency() [][]int {
+	result := make([][]int, len(t.Points))
+	if len(t.Triangles) == 0 {
+		for i := 1; i < len(t.Points); i++ {
+			result[i-1] = append(result[i-1], i)
+			result[i] = append(result[i], i-1)
+		}
+		return result
+	}
+	for e, v := range t.Triangles {
+		w := t.Triangles[next(e)]
+		// an inner edge is added from its half-edge with v < w, a hull edge from its only half-edge
+		if v < w || t.Halfedges[e] == -1 {
+			result[v] = append(result[v], w)
+			result[w] = append(result[w], v)
+		}
+	}
+	return result
+}
+
+// nearestPoint returns the index of the point nearest to p.
+func (t *Triangulation) nearestPoint(p matrix.Matrix) int {
+	nearest, minDist := 0, math.MaxFloat64
+	for i, v := range t.Points {
+		if d := graph.SquareDistance(p, v); d < minDist {
+			nearest, minDist = i, d
+		}
+	}
+	return nearest
+}
@@ -0,0 +1,125 @@
+package triangulate
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/graph"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/algorithm/relate"
+)
+
+func TestVoronoiDiagram(t *testing.T) {
+	tests := []struct {
+		name      string
+		sites     matrix.Steric
+		clip      *envelope.Envelope
+		tolerance float64
+		want      []VoronoiCell
+	}{
+		{name: "single point", sites: matrix.Matrix{1, 1}, want: []VoronoiCell{
+			{Polygon: matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, Site: matrix.Matrix{1, 1}, Indexes: []int{0}},
+		}},
+		{name: "two points clip", sites: matrix.LineMatrix{{3, 1}, {1, 1}}, clip: envelope.FourFloat(0, 4, 0, 2), want: []VoronoiCell{
+			{Polygon: matrix.PolygonMatrix{{{2, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 0}}}, Site: matrix.Matrix{3, 1}, Indexes: []int{0}},
+			{Polygon: matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, Site: matrix.Matrix{1, 1}, Indexes: []int{1}},
+		}},
+		{name: "collinear and duplicate", sites: matrix.Collection{matrix.Matrix{0, 0}, matrix.Matrix{1, 1}, matrix.Matrix{2, 2}, matrix.Matrix{0, 0}},
+			want: []VoronoiCell{
+				{Polygon: matrix.PolygonMatrix{{{-2, -2}, {3, -2}, {-2, 3}, {-2, -2}}}, Site: matrix.Matrix{0, 0}, Indexes: []int{0, 3}},
+				{Polygon: matrix.PolygonMatrix{{{3, -2}, {4, -2}, {4, -1}, {-1, 4}, {-2, 4}, {-2, 3}, {3, -2}}}, Site: matrix.Matrix{1, 1}, Indexes: []int{1}},
+				{Polygon: matrix.PolygonMatrix{{{4, -1}, {4, 4}, {-1, 4}, {4, -1}}}, Site: matrix.Matrix{2, 2}, Indexes: []int{2}},
+			}},
+		{name: "tolerance", sites: matrix.LineMatrix{{3, 1}, {1, 1}, {1.01, 1}}, clip: envelope.FourFloat(0, 4, 0, 2), tolerance: 0.1,
+			want: []VoronoiCell{
+				{Polygon: matrix.PolygonMatrix{{{2, 0}, {4, 0}, {4, 2}, {2, 2}, {2, 0}}}, Site: matrix.Matrix{3, 1}, Indexes: []int{0}},
+				{Polygon: matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, Site: matrix.Matrix{1, 1}, Indexes: []int{1, 2}},
+			}},
+		{name: "outside clip", sites: matrix.LineMatrix{{1, 1}, {10, 1}}, clip: envelope.FourFloat(0, 2, 0, 2), want: []VoronoiCell{
+			{Polygon: matrix.PolygonMatrix{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}, Site: matrix.Matrix{1, 1}, Indexes: []int{0}},
+			{Polygon: matrix.PolygonMatrix{}, Site: matrix.Matrix{10, 1}, Indexes: []int{1}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := VoronoiDiagram(tt.sites, tt.clip, tt.tolerance)
+			if len(got) != len(tt.want) {
+				t.Fatalf("VoronoiDiagram() = %v, want %v", got, tt.want)
+			}
+			for i, v := range got {
+				if !v.Polygon.EqualsExact(tt.want[i].Polygon, 0.000001) || !v.Site.Equals(tt.want[i].Site) ||
+					!equalIndexes(v.Indexes, tt.want[i].Indexes) {
+					t.Errorf("VoronoiDiagram()[%d] = %v, want %v", i, v, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestVoronoiDiagramRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	sites := matrix.Collection{}
+	for i := 0; i < 300; i++ {
+		sites = append(sites, matrix.Matrix{r.Float64() * 100, r.Float64() * 100})
+	}
+	cells := VoronoiDiagram(sites, envelope.FourFloat(0, 100, 0, 100), 0)
+	if len(cells) != len(sites) {
+		t.Fatalf("VoronoiDiagram() = %v cells, want %v", len(cells), len(sites))
+	}
+	area := 0.0
+	for i, v := range cells {
+		if v.Indexes[0] != i {
+			t.Errorf("VoronoiDiagram()[%d] index = %v", i, v.Indexes)
+		}
+		ring := v.Polygon[0]
+		for j := 0; j < len(ring)-1; j++ {
+			area += (ring[j][0]*ring[j+1][1] - ring[j+1][0]*ring[j][1]) / 2
+		}
+	}
+	if math.Abs(area-10000) > 1e-6 {
+		t.Errorf("VoronoiDiagram() area = %v, want 10000", area)
+	}
+	for k := 0; k < 1000; k++ {
+		p := matrix.Matrix{r.Float64() * 100, r.Float64() * 100}
+		nearest, minDist := 0, math.MaxFloat64
+		for i, v := range cells {
+			if d := graph.SquareDistance(p, v.Site); d < minDist {
+				nearest, minDist = i, d
+			}
+		}
+		if !relate.InPolygon(p, cells[nearest].Polygon[0]) {
+			t.Fatalf("point %v is not in the cell of its nearest site %v", p, cells[nearest].Site)
+		}
+	}
+}
+
+func TestVoronoiEdges(t *testing.T) {
+	grid := matrix.Collection{}
+	for i := 0; i < 5; i++ {
+		for j := 0; j < 5; j++ {
+			grid = append(grid, matrix.Matrix{float64(i), float64(j)})
+		}
+	}
+	if got := VoronoiEdges(grid, nil, 0); len(got) != 40 {
+		t.Errorf("VoronoiEdges() grid = %v edges, want 40", len(got))
+	}
+	got := VoronoiEdges(matrix.LineMatrix{{3, 1}, {1, 1}}, envelope.FourFloat(0, 4, 0, 2), 0)
+	want := matrix.LineMatrix{{2, 0}, {2, 2}}
+	if len(got) != 1 || !got[0].Equals(want) {
+		t.Errorf("VoronoiEdges() = %v, want %v", got, want)
+	}
+}
+
+func equalIndexes(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
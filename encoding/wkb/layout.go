@@ -0,0 +1,87 @@
+package wkb
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/space"
+)
+
+// const ISO WKB type offsets and EWKB type flags of the z and m ordinates.
+const (
+	isoZOffset uint32 = 1000
+	isoMOffset uint32 = 2000
+
+	ewkbZFlag uint32 = 0x80000000
+	ewkbMFlag uint32 = 0x40000000
+)
+
+// layout describes the ordinates of the coordinates, xy, xyz, xym or xyzm.
+type layout struct {
+	hasZ, hasM bool
+}
+
+// splitType returns the geometry type and the layout of an ISO WKB or EWKB type.
+func splitType(typ uint32) (uint32, layout) {
+	l := layout{hasZ: typ&ewkbZFlag != 0, hasM: typ&ewkbMFlag != 0}
+	typ &^= ewkbZFlag | ewkbMFlag | ewkbSRIDFlag
+	switch typ / 1000 {
+	case 1:
+		l.hasZ = true
+	case 2:
+		l.hasM = true
+	case 3:
+		l.hasZ, l.hasM = true, true
+	}
+	return typ % 1000, l
+}
+
+// layoutOf returns the layout of the geometry.
+func layoutOf(geom space.Geometry) layout {
+	return layout{hasZ: space.HasZ(geom), hasM: space.HasM(geom)}
+}
+
+// typ returns the ISO WKB type of the geometry type in the layout.
+func (l layout) typ(typ uint32) uint32 {
+	if l.hasZ {
+		typ += isoZOffset
+	}
+	if l.hasM {
+		typ += isoMOffset
+	}
+	return typ
+}
+
+// stride returns the number of ordinates of a coordinate.
+func (l layout) stride() int {
+	stride := 2
+	if l.hasZ {
+		stride++
+	}
+	if l.hasM {
+		stride++
+	}
+	return stride
+}
+
+// point returns the point of the ordinates of a coordinate.
+func (l layout) point(ordinates []float64) space.Point {
+	z, m, i := math.NaN(), math.NaN(), 2
+	if l.hasZ {
+		z = ordinates[i]
+		i++
+	}
+	if l.hasM {
+		m = ordinates[i]
+	}
+	return space.CreatePointZM(ordinates[0], ordinates[1], z, m)
+}
+
+// ordinates returns the ordinates of the point in the layout, a missing ordinate is 0.
+func (l layout) ordinates(p space.Point) []float64 {
+	ordinates := make([]float64, 4)
+	copy(ordinates, space.Force4D(p).(space.Point))
+	if !l.hasZ {
+		ordinates[2] = ordinates[3]
+	}
+	return ordinates[:l.stride()]
+}
@@ -3,13 +3,12 @@ package wkb
 import (
 	"errors"
 	"io"
-	"math"
 
 	"github.com/spatial-go/geoos/space"
 )
 
-func unmarshalLineString(order byteOrder, data []byte) (space.LineString, error) {
-	ps, err := unmarshalPoints(order, data)
+func unmarshalLineString(order byteOrder, data []byte, l layout) (space.LineString, error) {
+	ps, err := unmarshalPoints(order, data, l)
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +19,7 @@ func unmarshalLineString(order byteOrder, data []byte) (space.LineString, error)
 	return line, nil
 }
 
-func readLineString(r io.Reader, order byteOrder, buf []byte) (space.LineString, error) {
+func readLineString(r io.Reader, order byteOrder, buf []byte, l layout) (space.LineString, error) {
 	num, err := readUint32(r, order, buf[:4])
 	if err != nil {
 		return nil, err
@@ -34,7 +33,7 @@ func readLineString(r io.Reader, order byteOrder, buf []byte) (space.LineString,
 	result := make(space.LineString, 0, alloc)
 
 	for i := 0; i < int(num); i++ {
-		p, err := readPoint(r, order, buf)
+		p, err := readPoint(r, order, buf, l)
 		if err != nil {
 			return nil, err
 		}
@@ -45,8 +44,8 @@ func readLineString(r io.Reader, order byteOrder, buf []byte) (space.LineString,
 	return result, nil
 }
 
-func (e *Encoder) writeLineString(ls space.LineString) error {
-	e.order.PutUint32(e.buf, lineStringType)
+func (e *Encoder) writeLineString(ls space.LineString, l layout) error {
+	e.order.PutUint32(e.buf, l.typ(lineStringType))
 	e.order.PutUint32(e.buf[4:], uint32(len(ls)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
@@ -54,10 +53,7 @@ func (e *Encoder) writeLineString(ls space.LineString) error {
 	}
 
 	for _, p := range ls {
-		e.order.PutUint64(e.buf, math.Float64bits(p[0]))
-		e.order.PutUint64(e.buf[8:], math.Float64bits(p[1]))
-		_, err = e.w.Write(e.buf)
-		if err != nil {
+		if err := e.writeCoord(p, l); err != nil {
 			return err
 		}
 	}
@@ -65,7 +61,7 @@ func (e *Encoder) writeLineString(ls space.LineString) error {
 	return nil
 }
 
-func unmarshalMultiLineString(order byteOrder, data []byte) (space.MultiLineString, error) {
+func unmarshalMultiLineString(order byteOrder, data []byte, l layout) (space.MultiLineString, error) {
 	if len(data) < 4 {
 		return nil, ErrNotWKB
 	}
@@ -85,7 +81,7 @@ func unmarshalMultiLineString(order byteOrder, data []byte) (space.MultiLineStri
 			return nil, err
 		}
 
-		data = data[16*len(ls)+9:]
+		data = data[8*l.stride()*len(ls)+9:]
 		result = append(result, ls)
 	}
 
@@ -111,11 +107,12 @@ func readMultiLineString(r io.Reader, order byteOrder, buf []byte) (space.MultiL
 			return nil, err
 		}
 
+		typ, l := splitType(typ)
 		if typ != lineStringType {
 			return nil, errors.New("expect multilines to contains lines, did not find a line")
 		}
 
-		ls, err := readLineString(r, lOrder, buf)
+		ls, err := readLineString(r, lOrder, buf, l)
 		if err != nil {
 			return nil, err
 		}
@@ -126,8 +123,8 @@ func readMultiLineString(r io.Reader, order byteOrder, buf []byte) (space.MultiL
 	return result, nil
 }
 
-func (e *Encoder) writeMultiLineString(mls space.MultiLineString) error {
-	e.order.PutUint32(e.buf, multiLineStringType)
+func (e *Encoder) writeMultiLineString(mls space.MultiLineString, l layout) error {
+	e.order.PutUint32(e.buf, l.typ(multiLineStringType))
 	e.order.PutUint32(e.buf[4:], uint32(len(mls)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
@@ -9,14 +9,15 @@ import (
 	"github.com/spatial-go/geoos/space"
 )
 
-func unmarshalPoints(order byteOrder, data []byte) ([]space.Point, error) {
+func unmarshalPoints(order byteOrder, data []byte, l layout) ([]space.Point, error) {
 	if len(data) < 4 {
 		return nil, ErrNotWKB
 	}
 	num := unmarshalUint32(order, data)
 	data = data[4:]
 
-	if len(data) < int(num*16) {
+	size := 8 * l.stride()
+	if len(data) < int(num)*size {
 		return nil, ErrNotWKB
 	}
 
@@ -27,71 +28,68 @@ func unmarshalPoints(order byteOrder, data []byte) ([]space.Point, error) {
 	}
 	result := make([]space.Point, 0, alloc)
 
-	if order == littleEndian {
-		for i := 0; i < int(num); i++ {
-			result = append(result, space.Point{math.Float64frombits(binary.LittleEndian.Uint64(data[16*i:])),
-				math.Float64frombits(binary.LittleEndian.Uint64(data[16*i+8:])),
-			})
-		}
-	} else {
-		for i := 0; i < int(num); i++ {
-			result = append(result, space.Point{math.Float64frombits(binary.BigEndian.Uint64(data[16*i:])),
-				math.Float64frombits(binary.BigEndian.Uint64(data[16*i+8:])),
-			})
-		}
+	for i := 0; i < int(num); i++ {
+		p, _ := unmarshalPoint(order, data[size*i:], l)
+		result = append(result, p)
 	}
 
 	return result, nil
 }
 
-func unmarshalPoint(order byteOrder, buf []byte) (space.Point, error) {
-	if len(buf) < 16 {
+func unmarshalPoint(order byteOrder, buf []byte, l layout) (space.Point, error) {
+	if len(buf) < 8*l.stride() {
 		return space.Point{}, ErrNotWKB
 	}
 
-	var p space.Point = make(space.Point, 2)
-	if order == littleEndian {
-		p[0] = math.Float64frombits(binary.LittleEndian.Uint64(buf))
-		p[1] = math.Float64frombits(binary.LittleEndian.Uint64(buf[8:]))
-	} else {
-		p[0] = math.Float64frombits(binary.BigEndian.Uint64(buf))
-		p[1] = math.Float64frombits(binary.BigEndian.Uint64(buf[8:]))
+	ordinates := make([]float64, l.stride())
+	for i := range ordinates {
+		if order == littleEndian {
+			ordinates[i] = math.Float64frombits(binary.LittleEndian.Uint64(buf[8*i:]))
+		} else {
+			ordinates[i] = math.Float64frombits(binary.BigEndian.Uint64(buf[8*i:]))
+		}
 	}
 
-	return p, nil
+	return l.point(ordinates), nil
 }
 
-func readPoint(r io.Reader, order byteOrder, buf []byte) (space.Point, error) {
-	var p space.Point
+func readPoint(r io.Reader, order byteOrder, buf []byte, l layout) (space.Point, error) {
+	ordinates := make([]float64, 0, l.stride())
 
-	for i := 0; i < 2; i++ {
+	for i := 0; i < l.stride(); i++ {
 		if _, err := io.ReadFull(r, buf); err != nil {
 			return space.Point{}, err
 		}
 		if order == littleEndian {
-			p = append(p, math.Float64frombits(binary.LittleEndian.Uint64(buf)))
+			ordinates = append(ordinates, math.Float64frombits(binary.LittleEndian.Uint64(buf)))
 		} else {
-			p = append(p, math.Float64frombits(binary.BigEndian.Uint64(buf)))
+			ordinates = append(ordinates, math.Float64frombits(binary.BigEndian.Uint64(buf)))
 		}
 	}
 
-	return p, nil
+	return l.point(ordinates), nil
 }
 
-func (e *Encoder) writePoint(p space.Point) error {
-	e.order.PutUint32(e.buf, pointType)
+func (e *Encoder) writePoint(p space.Point, l layout) error {
+	e.order.PutUint32(e.buf, l.typ(pointType))
 	_, err := e.w.Write(e.buf[:4])
 	if err != nil {
 		return err
 	}
 
-	e.order.PutUint64(e.buf, math.Float64bits(p[0]))
-	e.order.PutUint64(e.buf[8:], math.Float64bits(p[1]))
-	_, err = e.w.Write(e.buf)
+	return e.writeCoord(p, l)
+}
+
+// writeCoord writes the ordinates of the point in the layout.
+func (e *Encoder) writeCoord(p space.Point, l layout) error {
+	for i, v := range l.ordinates(p) {
+		e.order.PutUint64(e.buf[8*i:], math.Float64bits(v))
+	}
+	_, err := e.w.Write(e.buf[:8*l.stride()])
 	return err
 }
 
-func unmarshalMultiPoint(order byteOrder, data []byte) (space.MultiPoint, error) {
+func unmarshalMultiPoint(order byteOrder, data []byte, l layout) (space.MultiPoint, error) {
 	if len(data) < 4 {
 		return nil, ErrNotWKB
 	}
@@ -111,7 +109,7 @@ func unmarshalMultiPoint(order byteOrder, data []byte) (space.MultiPoint, error)
 			return nil, err
 		}
 
-		data = data[21:]
+		data = data[5+8*l.stride():]
 		result = append(result, p)
 	}
 
@@ -137,11 +135,12 @@ func readMultiPoint(r io.Reader, order byteOrder, buf []byte) (space.MultiPoint,
 			return nil, err
 		}
 
+		typ, l := splitType(typ)
 		if typ != pointType {
 			return nil, errors.New("expect multipoint to contains points, did not find a point")
 		}
 
-		p, err := readPoint(r, pOrder, buf)
+		p, err := readPoint(r, pOrder, buf, l)
 		if err != nil {
 			return nil, err
 		}
@@ -152,8 +151,8 @@ func readMultiPoint(r io.Reader, order byteOrder, buf []byte) (space.MultiPoint,
 	return result, nil
 }
 
-func (e *Encoder) writeMultiPoint(mp space.MultiPoint) error {
-	e.order.PutUint32(e.buf, multiPointType)
+func (e *Encoder) writeMultiPoint(mp space.MultiPoint, l layout) error {
+	e.order.PutUint32(e.buf, l.typ(multiPointType))
 	e.order.PutUint32(e.buf[4:], uint32(len(mp)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
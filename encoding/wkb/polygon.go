@@ -3,12 +3,11 @@ package wkb
 import (
 	"errors"
 	"io"
-	"math"
 
 	"github.com/spatial-go/geoos/space"
 )
 
-func unmarshalPolygon(order byteOrder, data []byte) (space.Polygon, error) {
+func unmarshalPolygon(order byteOrder, data []byte, l layout) (space.Polygon, error) {
 	if len(data) < 4 {
 		return nil, ErrNotWKB
 	}
@@ -23,12 +22,12 @@ func unmarshalPolygon(order byteOrder, data []byte) (space.Polygon, error) {
 	result := make(space.Polygon, 0, alloc)
 
 	for i := 0; i < int(num); i++ {
-		ps, err := unmarshalPoints(order, data)
+		ps, err := unmarshalPoints(order, data, l)
 		if err != nil {
 			return nil, err
 		}
 
-		data = data[16*len(ps)+4:]
+		data = data[8*l.stride()*len(ps)+4:]
 
 		var line space.LineString
 		for _, p := range ps {
@@ -40,7 +39,7 @@ func unmarshalPolygon(order byteOrder, data []byte) (space.Polygon, error) {
 	return result, nil
 }
 
-func readPolygon(r io.Reader, order byteOrder, buf []byte) (space.Polygon, error) {
+func readPolygon(r io.Reader, order byteOrder, buf []byte, l layout) (space.Polygon, error) {
 	num, err := readUint32(r, order, buf[:4])
 	if err != nil {
 		return nil, err
@@ -54,7 +53,7 @@ func readPolygon(r io.Reader, order byteOrder, buf []byte) (space.Polygon, error
 	result := make(space.Polygon, 0, alloc)
 
 	for i := 0; i < int(num); i++ {
-		ls, err := readLineString(r, order, buf)
+		ls, err := readLineString(r, order, buf, l)
 		if err != nil {
 			return nil, err
 		}
@@ -65,8 +64,8 @@ func readPolygon(r io.Reader, order byteOrder, buf []byte) (space.Polygon, error
 	return result, nil
 }
 
-func (e *Encoder) writePolygon(p space.Polygon) error {
-	e.order.PutUint32(e.buf, polygonType)
+func (e *Encoder) writePolygon(p space.Polygon, l layout) error {
+	e.order.PutUint32(e.buf, l.typ(polygonType))
 	e.order.PutUint32(e.buf[4:], uint32(len(p)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
@@ -79,10 +78,7 @@ func (e *Encoder) writePolygon(p space.Polygon) error {
 			return err
 		}
 		for _, p := range r {
-			e.order.PutUint64(e.buf, math.Float64bits(p[0]))
-			e.order.PutUint64(e.buf[8:], math.Float64bits(p[1]))
-			_, err = e.w.Write(e.buf)
-			if err != nil {
+			if err := e.writeCoord(p, l); err != nil {
 				return err
 			}
 		}
@@ -90,7 +86,7 @@ func (e *Encoder) writePolygon(p space.Polygon) error {
 	return nil
 }
 
-func unmarshalMultiPolygon(order byteOrder, data []byte) (space.MultiPolygon, error) {
+func unmarshalMultiPolygon(order byteOrder, data []byte, l layout) (space.MultiPolygon, error) {
 	if len(data) < 4 {
 		return nil, ErrNotWKB
 	}
@@ -110,11 +106,11 @@ func unmarshalMultiPolygon(order byteOrder, data []byte) (space.MultiPolygon, er
 			return nil, err
 		}
 
-		l := 9
+		size := 9
 		for _, r := range p {
-			l += 4 + 16*len(r)
+			size += 4 + 8*l.stride()*len(r)
 		}
-		data = data[l:]
+		data = data[size:]
 
 		result = append(result, p)
 	}
@@ -141,11 +137,12 @@ func readMultiPolygon(r io.Reader, order byteOrder, buf []byte) (space.MultiPoly
 			return nil, err
 		}
 
+		typ, l := splitType(typ)
 		if typ != polygonType {
 			return nil, errors.New("expect multipolygons to contains polygons, did not find a polygon")
 		}
 
-		p, err := readPolygon(r, pOrder, buf)
+		p, err := readPolygon(r, pOrder, buf, l)
 		if err != nil {
 			return nil, err
 		}
@@ -156,8 +153,8 @@ func readMultiPolygon(r io.Reader, order byteOrder, buf []byte) (space.MultiPoly
 	return result, nil
 }
 
-func (e *Encoder) writeMultiPolygon(mp space.MultiPolygon) error {
-	e.order.PutUint32(e.buf, multiPolygonType)
+func (e *Encoder) writeMultiPolygon(mp space.MultiPolygon, l layout) error {
+	e.order.PutUint32(e.buf, l.typ(multiPolygonType))
 	e.order.PutUint32(e.buf[4:], uint32(len(mp)))
 	_, err := e.w.Write(e.buf[:8])
 	if err != nil {
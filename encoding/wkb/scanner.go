@@ -228,12 +228,13 @@ func scanPoint(data []byte) (space.Point, error) {
 	if err != nil {
 		return nil, err
 	}
+	typ, l := splitType(typ)
 
 	switch typ {
 	case pointType:
-		return unmarshalPoint(order, data[5:])
+		return unmarshalPoint(order, data[5:], l)
 	case multiPointType:
-		mp, err := unmarshalMultiPoint(order, data[5:])
+		mp, err := unmarshalMultiPoint(order, data[5:], l)
 		if err != nil {
 			return nil, err
 		}
@@ -266,12 +267,13 @@ func scanLineString(data []byte) (space.LineString, error) {
 	if err != nil {
 		return nil, err
 	}
+	typ, l := splitType(typ)
 
 	switch typ {
 	case lineStringType:
-		return unmarshalLineString(order, data[5:])
+		return unmarshalLineString(order, data[5:], l)
 	case multiLineStringType:
-		mls, err := unmarshalMultiLineString(order, data[5:])
+		mls, err := unmarshalMultiLineString(order, data[5:], l)
 		if err != nil {
 			return nil, err
 		}
@@ -288,17 +290,18 @@ func scanMultiLineString(data []byte) (space.MultiLineString, error) {
 	if err != nil {
 		return nil, err
 	}
+	typ, l := splitType(typ)
 
 	switch typ {
 	case lineStringType:
-		ls, err := unmarshalLineString(order, data[5:])
+		ls, err := unmarshalLineString(order, data[5:], l)
 		if err != nil {
 			return nil, err
 		}
 
 		return space.MultiLineString{ls}, nil
 	case multiLineStringType:
-		return unmarshalMultiLineString(order, data[5:])
+		return unmarshalMultiLineString(order, data[5:], l)
 	}
 
 	return nil, ErrIncorrectGeometry
@@ -309,12 +312,13 @@ func scanPolygon(data []byte) (space.Polygon, error) {
 	if err != nil {
 		return nil, err
 	}
+	typ, l := splitType(typ)
 
 	switch typ {
 	case polygonType:
-		return unmarshalPolygon(order, data[5:])
+		return unmarshalPolygon(order, data[5:], l)
 	case multiPolygonType:
-		mp, err := unmarshalMultiPolygon(order, data[5:])
+		mp, err := unmarshalMultiPolygon(order, data[5:], l)
 		if err != nil {
 			return nil, err
 		}
@@ -331,16 +335,17 @@ func scanMultiPolygon(data []byte) (space.MultiPolygon, error) {
 	if err != nil {
 		return nil, err
 	}
+	typ, l := splitType(typ)
 
 	switch typ {
 	case polygonType:
-		p, err := unmarshalPolygon(order, data[5:])
+		p, err := unmarshalPolygon(order, data[5:], l)
 		if err != nil {
 			return nil, err
 		}
 		return space.MultiPolygon{p}, nil
 	case multiPolygonType:
-		return unmarshalMultiPolygon(order, data[5:])
+		return unmarshalMultiPolygon(order, data[5:], l)
 	}
 
 	return nil, ErrIncorrectGeometry
@@ -26,6 +26,9 @@ const (
 	multiLineStringType    uint32 = 5
 	multiPolygonType       uint32 = 6
 	geometryCollectionType uint32 = 7
+
+	// ewkbSRIDFlag is set in the type of EWKB data when the SRID follows the type.
+	ewkbSRIDFlag uint32 = 0x20000000
 )
 
 const (
@@ -95,7 +98,11 @@ func (e *Encoder) SetByteOrder(bo binary.ByteOrder) {
 }
 
 // Encode will write the geometry encoded as WKB to the given writer.
+// A geometry tagged with an SRID is written as EWKB.
 func (e *Encoder) Encode(geom space.Geometry) error {
+	if g, ok := geom.(space.SRIDGeometry); ok {
+		return e.encodeSRID(g)
+	}
 	if geom == nil || geom.IsEmpty() {
 		return nil
 	}
@@ -157,54 +164,88 @@ func (e *Encoder) Encode(geom space.Geometry) error {
 	}
 
 	if e.buf == nil {
-		e.buf = make([]byte, 16)
+		e.buf = make([]byte, 32)
 	}
 
+	l := layoutOf(geom)
 	switch g := geom.(type) {
 	case space.Point:
-		return e.writePoint(g)
+		return e.writePoint(g, l)
 	case space.MultiPoint:
-		return e.writeMultiPoint(g)
+		return e.writeMultiPoint(g, l)
 	case space.LineString:
-		return e.writeLineString(g)
+		return e.writeLineString(g, l)
 	case space.MultiLineString:
-		return e.writeMultiLineString(g)
+		return e.writeMultiLineString(g, l)
 	case space.Polygon:
-		return e.writePolygon(g)
+		return e.writePolygon(g, l)
 	case space.MultiPolygon:
-		return e.writeMultiPolygon(g)
+		return e.writeMultiPolygon(g, l)
 	case space.Collection:
-		return e.writeCollection(g)
+		return e.writeCollection(g, l)
 	}
 
 	panic("unsupported type")
 }
 
+// encodeSRID writes the geometry as EWKB, the SRID follows the type which has the ewkbSRIDFlag set.
+func (e *Encoder) encodeSRID(geom space.SRIDGeometry) error {
+	data, err := Marshal(geom.Geometry, e.order)
+	if err != nil || len(data) == 0 {
+		return err
+	}
+
+	e.order.PutUint32(data[1:], e.order.Uint32(data[1:])|ewkbSRIDFlag)
+	if _, err := e.w.Write(data[:5]); err != nil {
+		return err
+	}
+
+	srid := make([]byte, 4)
+	e.order.PutUint32(srid, uint32(geom.SRID))
+	if _, err := e.w.Write(srid); err != nil {
+		return err
+	}
+
+	_, err = e.w.Write(data[5:])
+	return err
+}
+
 // Decoder can decoder WKB geometry off of the stream.
 type Decoder struct {
 	r io.Reader
 }
 
 // Unmarshal will decode the type into a Geometry.
+// EWKB data is decoded into a geometry tagged with its SRID.
 func Unmarshal(data []byte) (space.Geometry, error) {
+	srid := unmarshalSRID(data)
 	order, typ, data, err := unmarshalByteOrderType(data)
 	if err != nil {
 		return nil, err
 	}
 
+	geom, err := unmarshal(order, typ, data)
+	if err != nil {
+		return geom, err
+	}
+	return space.WithSRID(geom, srid), nil
+}
+
+func unmarshal(order byteOrder, typ uint32, data []byte) (space.Geometry, error) {
+	typ, l := splitType(typ)
 	switch typ {
 	case pointType:
-		return unmarshalPoint(order, data[5:])
+		return unmarshalPoint(order, data[5:], l)
 	case multiPointType:
-		return unmarshalMultiPoint(order, data[5:])
+		return unmarshalMultiPoint(order, data[5:], l)
 	case lineStringType:
-		return unmarshalLineString(order, data[5:])
+		return unmarshalLineString(order, data[5:], l)
 	case multiLineStringType:
-		return unmarshalMultiLineString(order, data[5:])
+		return unmarshalMultiLineString(order, data[5:], l)
 	case polygonType:
-		return unmarshalPolygon(order, data[5:])
+		return unmarshalPolygon(order, data[5:], l)
 	case multiPolygonType:
-		return unmarshalMultiPolygon(order, data[5:])
+		return unmarshalMultiPolygon(order, data[5:], l)
 	case geometryCollectionType:
 		g, err := NewDecoder(bytes.NewReader(data)).Decode()
 		if err == io.EOF || err == io.ErrUnexpectedEOF {
@@ -225,6 +266,7 @@ func NewDecoder(r io.Reader) *Decoder {
 }
 
 // Decode will decode the next geometry off of the stream.
+// EWKB data is decoded into a geometry tagged with its SRID.
 func (d *Decoder) Decode() (space.Geometry, error) {
 	buf := make([]byte, 8)
 	order, typ, err := readByteOrderType(d.r, buf)
@@ -232,17 +274,34 @@ func (d *Decoder) Decode() (space.Geometry, error) {
 		return nil, err
 	}
 
+	if typ&ewkbSRIDFlag == 0 {
+		return d.decode(order, typ, buf)
+	}
+
+	srid, err := readUint32(d.r, order, buf[:4])
+	if err != nil {
+		return nil, err
+	}
+	geom, err := d.decode(order, typ&^ewkbSRIDFlag, buf)
+	if err != nil {
+		return nil, err
+	}
+	return space.WithSRID(geom, int(srid)), nil
+}
+
+func (d *Decoder) decode(order byteOrder, typ uint32, buf []byte) (space.Geometry, error) {
+	typ, l := splitType(typ)
 	switch typ {
 	case pointType:
-		return readPoint(d.r, order, buf)
+		return readPoint(d.r, order, buf, l)
 	case multiPointType:
 		return readMultiPoint(d.r, order, buf)
 	case lineStringType:
-		return readLineString(d.r, order, buf)
+		return readLineString(d.r, order, buf, l)
 	case multiLineStringType:
 		return readMultiLineString(d.r, order, buf)
 	case polygonType:
-		return readPolygon(d.r, order, buf)
+		return readPolygon(d.r, order, buf, l)
 	case multiPolygonType:
 		return readMultiPolygon(d.r, order, buf)
 	case geometryCollectionType:
@@ -286,6 +345,9 @@ func readUint32(r io.Reader, order byteOrder, buf []byte) (uint32, error) {
 func unmarshalByteOrderType(buf []byte) (byteOrder, uint32, []byte, error) {
 	order, typ, err := byteOrderType(buf)
 	if err == nil {
+		if typ&ewkbSRIDFlag != 0 {
+			return stripSRID(order, typ, buf)
+		}
 		return order, typ, buf, nil
 	}
 
@@ -304,6 +366,33 @@ func unmarshalByteOrderType(buf []byte) (byteOrder, uint32, []byte, error) {
 	return order, typ, buf, nil
 }
 
+// unmarshalSRID returns the SRID of EWKB data, 0 if the data has none.
+func unmarshalSRID(buf []byte) int {
+	order, typ, err := byteOrderType(buf)
+	if err != nil || typ&ewkbSRIDFlag == 0 || len(buf) < 9 {
+		return 0
+	}
+	return int(unmarshalUint32(order, buf[5:]))
+}
+
+// stripSRID returns EWKB data as WKB, without the SRID and the flag in the type.
+func stripSRID(order byteOrder, typ uint32, buf []byte) (byteOrder, uint32, []byte, error) {
+	if len(buf) < 9 {
+		return 0, 0, nil, ErrNotWKB
+	}
+
+	typ &^= ewkbSRIDFlag
+	data := make([]byte, len(buf)-4)
+	data[0] = buf[0]
+	if order == littleEndian {
+		binary.LittleEndian.PutUint32(data[1:], typ)
+	} else {
+		binary.BigEndian.PutUint32(data[1:], typ)
+	}
+	copy(data[5:], buf[9:])
+	return order, typ, data, nil
+}
+
 func byteOrderType(buf []byte) (byteOrder, uint32, error) {
 	if len(buf) < 6 {
 		return 0, 0, ErrNotWKB
@@ -333,23 +422,25 @@ func unmarshalUint32(order byteOrder, buf []byte) uint32 {
 // geomLength helps to do preallocation during a marshal.
 func geomLength(geom space.Geometry) int {
 	switch g := geom.(type) {
+	case space.SRIDGeometry:
+		return 4 + geomLength(g.Geometry)
 	case space.Point:
-		return 21
+		return 5 + 8*layoutOf(g).stride()
 	case space.MultiPoint:
-		return 9 + 21*len(g)
+		return 9 + (5+8*layoutOf(g).stride())*len(g)
 	case space.LineString:
-		return 9 + 16*len(g)
+		return 9 + 8*layoutOf(g).stride()*len(g)
 	case space.MultiLineString:
 		sum := 0
 		for _, ls := range g {
-			sum += 9 + 16*len(ls)
+			sum += 9 + 8*layoutOf(ls).stride()*len(ls)
 		}
 
 		return 9 + sum
 	case space.Polygon:
 		sum := 0
 		for _, r := range g {
-			sum += 4 + 16*len(r)
+			sum += 4 + 8*layoutOf(g).stride()*len(r)
 		}
 
 		return 9 + sum
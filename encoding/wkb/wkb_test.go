@@ -3,7 +3,9 @@ package wkb
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
 	"io/ioutil"
+	"math"
 	"testing"
 
 	"github.com/spatial-go/geoos/space"
@@ -164,3 +166,113 @@ func compare(t testing.TB, e space.Geometry, b []byte) {
 		t.Errorf("scan: incorrect geometry: %v != %v", sg, e)
 	}
 }
+
+func TestEWKB(t *testing.T) {
+	// SRID=4326;POINT(1 2) and SRID=3857;LINESTRING(1 2,3 4) as written by PostGIS.
+	tests := []struct {
+		name string
+		data string
+		want space.Geometry
+	}{
+		{name: "point", data: "0101000020e6100000000000000000f03f0000000000000040",
+			want: space.WithSRID(space.Point{1, 2}, space.WGS84)},
+		{name: "linestring big endian", data: "002000000200000f1100000002" +
+			"3ff000000000000040000000000000004008000000000000" + "4010000000000000",
+			want: space.WithSRID(space.LineString{{1, 2}, {3, 4}}, space.PseudoMercator)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.data)
+			for _, g := range []space.Geometry{mustUnmarshalEWKB(t, b), mustDecodeEWKB(t, b)} {
+				if space.SRIDOf(g) != space.SRIDOf(tt.want) || !g.Equals(tt.want) {
+					t.Errorf("decode = %v, want %v", g, tt.want)
+				}
+			}
+
+			order := binary.ByteOrder(binary.LittleEndian)
+			if b[0] == 0 {
+				order = binary.BigEndian
+			}
+			if data, _ := Marshal(tt.want, order); !bytes.Equal(data, b) || len(data) != geomLength(tt.want) {
+				t.Errorf("Marshal() = %x, want %x", data, b)
+			}
+
+			// typed scans drop the SRID.
+			var sg space.Geometry
+			switch tt.want.(space.SRIDGeometry).Geometry.(type) {
+			case space.Point:
+				var p space.Point
+				_ = Scanner(&p).Scan(b)
+				sg = p
+			case space.LineString:
+				var ls space.LineString
+				_ = Scanner(&ls).Scan(b)
+				sg = ls
+			}
+			if !sg.Equals(space.Unwrap(tt.want)) {
+				t.Errorf("Scan() = %v, want %v", sg, tt.want)
+			}
+		})
+	}
+}
+
+func mustUnmarshalEWKB(t *testing.T, b []byte) space.Geometry {
+	t.Helper()
+	g, err := Unmarshal(b)
+	if err != nil {
+		t.Fatalf("unmarshal: read error: %v", err)
+	}
+	return g
+}
+
+func mustDecodeEWKB(t *testing.T, b []byte) space.Geometry {
+	t.Helper()
+	g, err := NewDecoder(bytes.NewReader(b)).Decode()
+	if err != nil {
+		t.Fatalf("decoder: read error: %v", err)
+	}
+	return g
+}
+
+func TestWKB_ZM(t *testing.T) {
+	// POINT Z (1 2 3), POINT M (1 2 4) and POINT ZM (1 2 3 4) as ISO WKB, POINT(1 2 3) as EWKB.
+	tests := []struct {
+		name string
+		data string
+		want space.Geometry
+		iso  bool
+	}{
+		{name: "point z", data: "01e9030000000000000000f03f00000000000000400000000000000840",
+			want: space.Point{1, 2, 3}, iso: true},
+		{name: "point m", data: "01d1070000000000000000f03f00000000000000400000000000001040",
+			want: space.CreatePointZM(1, 2, math.NaN(), 4), iso: true},
+		{name: "point zm", data: "01b90b0000000000000000f03f000000000000004000000000000008400000000000001040",
+			want: space.Point{1, 2, 3, 4}, iso: true},
+		{name: "ewkb point z", data: "0101000080000000000000f03f00000000000000400000000000000840",
+			want: space.Point{1, 2, 3}},
+		{name: "linestring z", data: "01ea03000002000000" +
+			"000000000000f03f00000000000000400000000000000840" +
+			"000000000000104000000000000014400000000000001840",
+			want: space.LineString{{1, 2, 3}, {4, 5, 6}}, iso: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt.data)
+			for _, g := range []space.Geometry{mustUnmarshalEWKB(t, b), mustDecodeEWKB(t, b)} {
+				if !g.Equals(tt.want) || space.HasZ(g) != space.HasZ(tt.want) || space.HasM(g) != space.HasM(tt.want) {
+					t.Errorf("decode = %v, want %v", g, tt.want)
+				}
+			}
+			if data, _ := Marshal(tt.want, binary.LittleEndian); tt.iso && (!bytes.Equal(data, b) || len(data) != geomLength(tt.want)) {
+				t.Errorf("Marshal() = %x, want %x", data, b)
+			}
+		})
+	}
+
+	mp := space.MultiPolygon{{{{0, 0, 1}, {1, 0, 1}, {1, 1, 1}, {0, 0, 1}}}, {{{2, 2, 1}, {3, 2, 1}, {3, 3, 1}, {2, 2, 1}}}}
+	data, _ := Marshal(mp, binary.BigEndian)
+	var got space.MultiPolygon
+	if err := Scanner(&got).Scan(data); err != nil || !got.Equals(mp) || !space.HasZ(got) {
+		t.Errorf("Scan() = %v, %v, want %v", got, err, mp)
+	}
+}
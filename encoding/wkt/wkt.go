@@ -3,6 +3,7 @@ package wkt
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"strings"
 
 	"github.com/spatial-go/geoos/space"
@@ -15,6 +16,7 @@ func UnmarshalString(s string) (space.Geometry, error) {
 }
 
 // MarshalString decode to string
+// A geometry tagged with an SRID is written as EWKT, SRID=<srid>;<wkt>.
 func MarshalString(geom space.Geometry) string {
 	buf := bytes.NewBuffer(nil)
 	wkt(buf, geom)
@@ -22,21 +24,29 @@ func MarshalString(geom space.Geometry) string {
 }
 
 func wkt(buf *bytes.Buffer, geom space.Geometry) {
+	dim := dimensionOf(geom)
 	switch g := geom.(type) {
+	case space.SRIDGeometry:
+		_, _ = fmt.Fprintf(buf, "SRID=%d;", g.SRID)
+		wkt(buf, g.Geometry)
 	case space.Point:
-		_, _ = fmt.Fprintf(buf, "POINT(%g %g)", g.Lon(), g.Lat())
+		_, _ = fmt.Fprintf(buf, "POINT%s(", dim.tag())
+		writeCoord(buf, g, dim)
+		buf.WriteByte(')')
 	case space.MultiPoint:
 		if len(g) == 0 {
 			buf.Write([]byte(`MULTIPOINT EMPTY`))
 			return
 		}
 
-		buf.Write([]byte(`MULTIPOINT(`))
+		_, _ = fmt.Fprintf(buf, "MULTIPOINT%s(", dim.tag())
 		for i, p := range g.ToPointArray() {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			_, _ = fmt.Fprintf(buf, "(%g %g)", p.Lon(), p.Lat())
+			buf.WriteByte('(')
+			writeCoord(buf, p, dim)
+			buf.WriteByte(')')
 		}
 		buf.WriteByte(')')
 	case space.LineString:
@@ -45,20 +55,20 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			return
 		}
 
-		buf.Write([]byte(`LINESTRING`))
-		writeLineString(buf, g)
+		_, _ = fmt.Fprintf(buf, "LINESTRING%s", dim.tag())
+		writeLineString(buf, g, dim)
 	case space.MultiLineString:
 		if len(g) == 0 {
 			buf.Write([]byte(`MULTILINESTRING EMPTY`))
 			return
 		}
 
-		buf.Write([]byte(`MULTILINESTRING(`))
+		_, _ = fmt.Fprintf(buf, "MULTILINESTRING%s(", dim.tag())
 		for i, ls := range g {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			writeLineString(buf, ls)
+			writeLineString(buf, ls, dim)
 		}
 		buf.WriteByte(')')
 	case space.Ring:
@@ -69,12 +79,12 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			return
 		}
 
-		buf.Write([]byte(`POLYGON(`))
+		_, _ = fmt.Fprintf(buf, "POLYGON%s(", dim.tag())
 		for i, r := range g {
 			if i != 0 {
 				buf.WriteByte(',')
 			}
-			writeLineString(buf, space.LineString(r))
+			writeLineString(buf, space.LineString(r), dim)
 		}
 		buf.WriteByte(')')
 	case space.MultiPolygon:
@@ -83,7 +93,7 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			return
 		}
 
-		buf.Write([]byte(`MULTIPOLYGON(`))
+		_, _ = fmt.Fprintf(buf, "MULTIPOLYGON%s(", dim.tag())
 		for i, p := range g {
 			if i != 0 {
 				buf.WriteByte(',')
@@ -93,7 +103,7 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 				if j != 0 {
 					buf.WriteByte(',')
 				}
-				writeLineString(buf, space.LineString(r))
+				writeLineString(buf, space.LineString(r), dim)
 			}
 			buf.WriteByte(')')
 		}
@@ -104,7 +114,7 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 			buf.Write([]byte(`GEOMETRYCOLLECTION EMPTY`))
 			return
 		}
-		buf.Write([]byte(`GEOMETRYCOLLECTION(`))
+		_, _ = fmt.Fprintf(buf, "GEOMETRYCOLLECTION%s(", dim.tag())
 		for i, c := range g {
 			if i != 0 {
 				buf.WriteByte(',')
@@ -117,14 +127,55 @@ func wkt(buf *bytes.Buffer, geom space.Geometry) {
 	}
 }
 
-func writeLineString(buf *bytes.Buffer, ls space.LineString) {
+func writeLineString(buf *bytes.Buffer, ls space.LineString, dim dimension) {
 	buf.WriteByte('(')
 	for i, p := range ls.ToPointArray() {
 		if i != 0 {
 			buf.WriteByte(',')
 		}
 
-		_, _ = fmt.Fprintf(buf, "%g %g", p.Lon(), p.Lat())
+		writeCoord(buf, p, dim)
 	}
 	buf.WriteByte(')')
 }
+
+// writeCoord writes the ordinates of the point in dim, a missing ordinate is written as 0.
+func writeCoord(buf *bytes.Buffer, p space.Point, dim dimension) {
+	_, _ = fmt.Fprintf(buf, "%g %g", p.Lon(), p.Lat())
+	if dim.hasZ {
+		_, _ = fmt.Fprintf(buf, " %g", ordinate(p.Z()))
+	}
+	if dim.hasM {
+		_, _ = fmt.Fprintf(buf, " %g", ordinate(p.M()))
+	}
+}
+
+func ordinate(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return v
+}
+
+// dimension describes the ordinates written beyond x and y.
+type dimension struct {
+	hasZ, hasM bool
+}
+
+func dimensionOf(geom space.Geometry) dimension {
+	return dimension{hasZ: space.HasZ(geom), hasM: space.HasM(geom)}
+}
+
+// tag returns the dimension tag following the geometry type, e.g. POINT Z (1 2 3).
+func (d dimension) tag() string {
+	switch {
+	case d.hasZ && d.hasM:
+		return " ZM "
+	case d.hasZ:
+		return " Z "
+	case d.hasM:
+		return " M "
+	default:
+		return ""
+	}
+}
@@ -16,12 +16,15 @@ const (
 	LeftParen tokenType = iota
 	RightParen
 	Comma
+	Equal
+	Semicolon
 
 	// Keyword
 	Empty
 	Z
 	M
 	ZM
+	Srid
 
 	// Geometry type
 	PointEnum
@@ -89,6 +92,24 @@ func (l *Lexer) peek() rune {
 	return ch
 }
 
+// nextIsFloat skips spaces and returns true if the next lexeme is a float.
+func (l *Lexer) nextIsFloat() bool {
+	return beginFloat(l.peekNonSpace())
+}
+
+// nextIs skips spaces and returns true if the next rune is r.
+func (l *Lexer) nextIs(r rune) bool {
+	return l.peekNonSpace() == r
+}
+
+func (l *Lexer) peekNonSpace() rune {
+	for unicode.IsSpace(l.peek()) {
+		l.read()
+		l.pos++
+	}
+	return l.peek()
+}
+
 // scanToLowerWord scan a word and returns its value in lower letters
 func (l *Lexer) scanToLowerWord(r rune) string {
 	var buf bytes.Buffer
@@ -130,6 +151,10 @@ func (l *Lexer) scanToken() (Token, error) {
 		return l.getToken(RightParen, ")"), nil
 	case r == ',':
 		return l.getToken(Comma, ","), nil
+	case r == '=':
+		return l.getToken(Equal, "="), nil
+	case r == ';':
+		return l.getToken(Semicolon, ";"), nil
 	case unicode.IsLetter(r):
 		w := l.scanToLowerWord(r)
 		switch w {
@@ -141,6 +166,8 @@ func (l *Lexer) scanToken() (Token, error) {
 			return l.getToken(M, "m"), nil
 		case "zm":
 			return l.getToken(ZM, "zm"), nil
+		case "srid":
+			return l.getToken(Srid, "srid"), nil
 		case "point":
 			return l.getToken(PointEnum, "point"), nil
 		case "linestring":
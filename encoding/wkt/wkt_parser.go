@@ -2,6 +2,7 @@ package wkt
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 
 	"github.com/spatial-go/geoos/space"
@@ -13,11 +14,55 @@ type Parser struct {
 }
 
 // Parse ...
+// An EWKT prefix SRID=<srid>; tags the geometry with the SRID, see space.SRIDGeometry.
 func (p *Parser) Parse() (space.Geometry, error) {
 	t, err := p.scanToken()
 	if err != nil {
 		return nil, err
 	}
+	if t.ttype != Srid {
+		return p.parseGeometry(t)
+	}
+	srid, err := p.parseSRID()
+	if err != nil {
+		return nil, err
+	}
+	if t, err = p.scanToken(); err != nil {
+		return nil, err
+	}
+	geom, err := p.parseGeometry(t)
+	if err != nil {
+		return nil, err
+	}
+	return space.WithSRID(geom, srid), nil
+}
+
+// parseSRID parses the =<srid>; following the SRID keyword.
+func (p *Parser) parseSRID() (int, error) {
+	t, err := p.scanToken()
+	if err != nil {
+		return 0, err
+	}
+	if t.ttype != Equal {
+		return 0, fmt.Errorf("parse srid unexpected token %s on pos %d expected =", t.lexeme, t.pos)
+	}
+	if t, err = p.scanToken(); err != nil {
+		return 0, err
+	}
+	srid, err := strconv.Atoi(t.lexeme)
+	if t.ttype != Float || err != nil {
+		return 0, fmt.Errorf("parse srid unexpected token %s on pos %d expected srid", t.lexeme, t.pos)
+	}
+	if t, err = p.scanToken(); err != nil {
+		return 0, err
+	}
+	if t.ttype != Semicolon {
+		return 0, fmt.Errorf("parse srid unexpected token %s on pos %d expected ;", t.lexeme, t.pos)
+	}
+	return srid, nil
+}
+
+func (p *Parser) parseGeometry(t Token) (space.Geometry, error) {
 	switch t.ttype {
 	case PointEnum:
 		return p.parsePoint()
@@ -64,14 +109,7 @@ func (p *Parser) parsePoint() (point space.Point, err error) {
 		}
 		fallthrough
 	case LeftParen:
-		switch t.ttype { // reswitch on the type because of the fallthrough
-		case Z, M:
-			point, err = p.parseCoordDrop1()
-		case ZM:
-			point, err = p.parseCoordDrop2()
-		default:
-			point, err = p.parseCoord()
-		}
+		point, err = p.parseCoordZM(t.ttype)
 		if err != nil {
 			return point, err
 		}
@@ -142,18 +180,20 @@ func (p *Parser) parseLineString() (line space.LineString, err error) {
 func (p *Parser) parseLineStringText(ttype tokenType) (line space.LineString, err error) {
 	line = make([][]float64, 0)
 	for {
-		var point space.Point
-		switch ttype {
-		case Z, M:
-			point, err = p.parseCoordDrop1()
-		case ZM:
-			point, err = p.parseCoordDrop2()
-		default:
-			point, err = p.parseCoord()
+		// the points of a multipoint may be wrapped, e.g. MULTIPOINT((1 2),(3 4)).
+		wrapped := p.nextIs('(')
+		if wrapped {
+			_, _ = p.scanToken()
 		}
+		point, err := p.parseCoordZM(ttype)
 		if err != nil {
 			return line, err
 		}
+		if wrapped {
+			if t, err := p.scanToken(); err != nil || t.ttype != RightParen {
+				return line, fmt.Errorf("unexpected token %s on pos %d expected ')'", t.lexeme, t.pos)
+			}
+		}
 		line = append(line, point)
 		t, err := p.scanToken()
 		if err != nil {
@@ -334,41 +374,49 @@ func (p *Parser) parseCoord() (point space.Point, err error) {
 	return space.Point{c1, c2}, nil
 }
 
-func (p *Parser) parseCoordDrop1() (point space.Point, err error) {
+// parseCoordZM parses a coordinate with the ordinates of the dimension ttype, Z, M or ZM.
+// Without a dimension the z and m ordinates are optional, e.g. POINT(1 2 3) is a xyz point.
+func (p *Parser) parseCoordZM(ttype tokenType) (point space.Point, err error) {
 	point, err = p.parseCoord()
 	if err != nil {
 		return point, err
 	}
 
-	// drop the last value Z or M coordinates are not really supported
-	t, err := p.scanToken()
+	z, m := math.NaN(), math.NaN()
+	switch ttype {
+	case Z:
+		z, err = p.parseOrdinate()
+	case M:
+		m, err = p.parseOrdinate()
+	case ZM:
+		if z, err = p.parseOrdinate(); err == nil {
+			m, err = p.parseOrdinate()
+		}
+	default:
+		if p.nextIsFloat() {
+			z, err = p.parseOrdinate()
+		}
+		if err == nil && p.nextIsFloat() {
+			m, err = p.parseOrdinate()
+		}
+	}
 	if err != nil {
 		return point, err
 	}
-	if t.ttype != Float {
-		return point, fmt.Errorf("parseCoordDrop1 unexpected token %s on pos %d expected Float", t.lexeme, t.pos)
-	}
-
-	return point, nil
+	return space.CreatePointZM(point[0], point[1], z, m), nil
 }
 
-func (p *Parser) parseCoordDrop2() (point space.Point, err error) {
-	point, err = p.parseCoord()
+func (p *Parser) parseOrdinate() (float64, error) {
+	t, err := p.scanToken()
 	if err != nil {
-		return point, err
+		return 0, err
 	}
-
-	// drop the last value M values
-	// and Z coordinates are not really supported
-	for i := 0; i < 2; i++ {
-		t, err := p.scanToken()
-		if err != nil {
-			return point, err
-		}
-		if t.ttype != Float {
-			return point, fmt.Errorf("parseCoordDrop2 unexpected token %s on pos %d expected Float", t.lexeme, t.pos)
-		}
+	if t.ttype != Float {
+		return 0, fmt.Errorf("parse coordinates unexpected token %s on pos %d expected Float", t.lexeme, t.pos)
 	}
-
-	return point, nil
+	c, err := strconv.ParseFloat(t.lexeme, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid lexeme %s for token on pos %d", t.lexeme, t.pos)
+	}
+	return c, nil
 }
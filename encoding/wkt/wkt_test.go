@@ -1,6 +1,7 @@
 package wkt
 
 import (
+	"math"
 	"testing"
 
 	"github.com/spatial-go/geoos/space"
@@ -27,3 +28,83 @@ func TestMarshalString(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalString_SRID(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "ewkt point", s: "SRID=4326;POINT(116.4 39.9)", want: space.WithSRID(space.Point{116.4, 39.9}, space.WGS84)},
+		{name: "ewkt linestring", s: "srid=3857; LINESTRING(50 100,50 200)",
+			want: space.WithSRID(space.LineString{{50, 100}, {50, 200}}, space.PseudoMercator)},
+		{name: "wkt", s: "POINT(1 2)", want: space.Point{1, 2}},
+		{name: "missing semicolon", s: "SRID=4326 POINT(1 2)", wantErr: true},
+		{name: "invalid srid", s: "SRID=43.26;POINT(1 2)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshalString(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalString() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if space.SRIDOf(got) != space.SRIDOf(tt.want) || !got.Equals(tt.want) {
+				t.Errorf("UnmarshalString() = %v, want %v", got, tt.want)
+			}
+			if s := MarshalString(got); !space.Unwrap(tt.want).Equals(space.Unwrap(mustUnmarshal(t, s))) {
+				t.Errorf("MarshalString() = %v does not round trip", s)
+			}
+		})
+	}
+	if got := MarshalString(space.WithSRID(space.Point{1, 2}, space.WGS84)); got != "SRID=4326;POINT(1 2)" {
+		t.Errorf("MarshalString() = %v, want SRID=4326;POINT(1 2)", got)
+	}
+}
+
+func mustUnmarshal(t *testing.T, s string) space.Geometry {
+	t.Helper()
+	geom, err := UnmarshalString(s)
+	if err != nil {
+		t.Fatalf("UnmarshalString(%v) error = %v", s, err)
+	}
+	return geom
+}
+
+func TestUnmarshalString_ZM(t *testing.T) {
+	xym := space.CreatePointZM(1, 2, math.NaN(), 4)
+	tests := []struct {
+		name string
+		s    string
+		want space.Geometry
+		wkt  string
+	}{
+		{name: "point z", s: "POINT Z (1 2 3)", want: space.Point{1, 2, 3}, wkt: "POINT Z (1 2 3)"},
+		{name: "point implicit z", s: "POINT(1 2 3)", want: space.Point{1, 2, 3}, wkt: "POINT Z (1 2 3)"},
+		{name: "point m", s: "POINT M (1 2 4)", want: xym, wkt: "POINT M (1 2 4)"},
+		{name: "point zm", s: "POINT ZM (1 2 3 4)", want: space.Point{1, 2, 3, 4}, wkt: "POINT ZM (1 2 3 4)"},
+		{name: "linestring z", s: "LINESTRING Z (1 2 3,4 5 6)",
+			want: space.LineString{{1, 2, 3}, {4, 5, 6}}, wkt: "LINESTRING Z (1 2 3,4 5 6)"},
+		{name: "multipoint z", s: "MULTIPOINT Z ((1 2 3),(4 5 6))",
+			want: space.MultiPoint{{1, 2, 3}, {4, 5, 6}}, wkt: "MULTIPOINT Z ((1 2 3),(4 5 6))"},
+		{name: "polygon zm", s: "POLYGON ZM ((0 0 1 2,1 0 1 2,1 1 1 2,0 0 1 2))",
+			want: space.Polygon{{{0, 0, 1, 2}, {1, 0, 1, 2}, {1, 1, 1, 2}, {0, 0, 1, 2}}},
+			wkt:  "POLYGON ZM ((0 0 1 2,1 0 1 2,1 1 1 2,0 0 1 2))"},
+		{name: "ewkt z", s: "SRID=4326;POINT Z (1 2 3)",
+			want: space.WithSRID(space.Point{1, 2, 3}, space.WGS84), wkt: "SRID=4326;POINT Z (1 2 3)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mustUnmarshal(t, tt.s)
+			if !got.Equals(tt.want) || space.HasZ(got) != space.HasZ(tt.want) || space.HasM(got) != space.HasM(tt.want) {
+				t.Errorf("UnmarshalString() = %v, want %v", got, tt.want)
+			}
+			if s := MarshalString(got); s != tt.wkt {
+				t.Errorf("MarshalString() = %v, want %v", s, tt.wkt)
+			}
+		})
+	}
+}
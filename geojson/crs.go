@@ -0,0 +1,42 @@
+package geojson
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CRS is for the geojson crs attribute of a named coordinate reference system,
+// e.g. {"type":"name","properties":{"name":"EPSG:4326"}}.
+type CRS struct {
+	Type       string                 `json:"type"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// NewCRS creates a named crs from a SRID, nil if the SRID is 0.
+func NewCRS(srid int) *CRS {
+	if srid == 0 {
+		return nil
+	}
+	return &CRS{
+		Type:       "name",
+		Properties: map[string]interface{}{"name": fmt.Sprintf("EPSG:%d", srid)},
+	}
+}
+
+// SRID returns the SRID of a named crs, e.g. EPSG:4326 or urn:ogc:def:crs:EPSG::4326.
+// It returns 0 if the crs is not named by a SRID.
+func (c *CRS) SRID() int {
+	if c == nil || c.Type != "name" {
+		return 0
+	}
+	name, ok := c.Properties["name"].(string)
+	if !ok {
+		return 0
+	}
+	srid, err := strconv.Atoi(name[strings.LastIndex(name, ":")+1:])
+	if err != nil {
+		return 0
+	}
+	return srid
+}
@@ -11,10 +11,12 @@ import (
 var ErrInvalidGeometry = errors.New("geojson: invalid geometry")
 
 // A Geometry matches the structure of a GeoJSON Geometry.
+// The crs member carries the SRID of a space.SRIDGeometry.
 type Geometry struct {
 	Type        string         `json:"type"`
 	Coordinates space.Geometry `json:"coordinates,omitempty"`
 	Geometries  []*Geometry    `json:"geometries,omitempty"`
+	CRS         *CRS           `json:"crs,omitempty"`
 }
 
 // NewGeometry will create a Geometry object but will convert
@@ -23,6 +25,10 @@ type Geometry struct {
 func NewGeometry(g space.Geometry) *Geometry {
 	jg := &Geometry{}
 	switch g := g.(type) {
+	case space.SRIDGeometry:
+		jg = NewGeometry(g.Geometry)
+		jg.CRS = NewCRS(g.SRID)
+		return jg
 	case space.Ring:
 		jg.Coordinates = space.Polygon{g}
 	case space.Bound:
@@ -48,16 +54,21 @@ func NewGeometry(g space.Geometry) *Geometry {
 
 // Geometry returns the space.Geometry for the geojson Geometry.
 // This will convert the "Geometries" into a space.Collection if applicable.
+// A geometry with a named crs is returned as a space.SRIDGeometry.
 func (g Geometry) Geometry() space.Geometry {
-	if g.Coordinates != nil {
-		return g.Coordinates
+	var geom space.Geometry = g.Coordinates
+	if geom == nil {
+		c := make(space.Collection, 0, len(g.Geometries))
+		for _, geom := range g.Geometries {
+			c = append(c, geom.Geometry())
+		}
+		geom = c
 	}
 
-	c := make(space.Collection, 0, len(g.Geometries))
-	for _, geom := range g.Geometries {
-		c = append(c, geom.Geometry())
+	if srid := g.CRS.SRID(); srid != 0 {
+		return space.WithSRID(geom, srid)
 	}
-	return c
+	return geom
 }
 
 // MarshalJSON will marshal the geometry into the correct json structure.
@@ -66,8 +77,17 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 		return []byte(`null`), nil
 	}
 
-	ng := &jsonGeometryMarshall{}
-	switch g := g.Coordinates.(type) {
+	ng := &jsonGeometryMarshall{CRS: g.CRS}
+	coordinates := g.Coordinates
+	if sg, ok := coordinates.(space.SRIDGeometry); ok {
+		coordinates = sg.Geometry
+		ng.CRS = NewCRS(sg.SRID)
+	}
+	if space.HasM(coordinates) && !space.HasZ(coordinates) {
+		// geojson positions have no m, a xym position is written as xyzm with a z of 0.
+		coordinates = space.Force4D(coordinates)
+	}
+	switch g := coordinates.(type) {
 	case space.Ring:
 		ng.Coordinates = space.Polygon{g}
 	case space.Bound:
@@ -148,6 +168,7 @@ func (g *Geometry) UnmarshalJSON(data []byte) error {
 		return ErrInvalidGeometry
 	}
 
+	g.CRS = jg.CRS
 	g.Type = g.Geometry().GeoJSONType()
 
 	return nil
@@ -335,12 +356,14 @@ type jsonGeometry struct {
 	Type        string           `json:"type"`
 	Coordinates nocopyRawMessage `json:"coordinates"`
 	Geometries  []*Geometry      `json:"geometries,omitempty"`
+	CRS         *CRS             `json:"crs,omitempty"`
 }
 
 type jsonGeometryMarshall struct {
 	Type        string         `json:"type"`
 	Coordinates space.Geometry `json:"coordinates,omitempty"`
 	Geometries  []*Geometry    `json:"geometries,omitempty"`
+	CRS         *CRS           `json:"crs,omitempty"`
 }
 
 type nocopyRawMessage []byte
@@ -2,6 +2,8 @@ package geojson
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
 	"reflect"
 	"strings"
 	"testing"
@@ -331,3 +333,70 @@ func BenchmarkGeometryUnmarshalJSON(b *testing.B) {
 		g.UnmarshalJSON(data)
 	}
 }
+
+func TestGeometryCRS(t *testing.T) {
+	cases := []struct {
+		name string
+		geom space.Geometry
+	}{
+		{name: "point", geom: space.WithSRID(space.Point{116.4, 39.9}, space.WGS84)},
+		{name: "collection", geom: space.WithSRID(space.Collection{space.Point{1, 2}}, space.PseudoMercator)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(NewGeometry(tc.geom))
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			name := fmt.Sprintf(`"crs":{"type":"name","properties":{"name":"EPSG:%d"}}`, space.SRIDOf(tc.geom))
+			if !strings.Contains(string(data), name) {
+				t.Errorf("crs not written: %s", data)
+			}
+
+			g, err := UnmarshalGeometry(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+			if got := g.Geometry(); space.SRIDOf(got) != space.SRIDOf(tc.geom) || !got.Equals(tc.geom) {
+				t.Errorf("incorrect geometry: %v != %v", got, tc.geom)
+			}
+		})
+	}
+
+	g, err := UnmarshalGeometry([]byte(`{"type":"Point","coordinates":[1,2],"crs":{"type":"name","properties":{"name":"urn:ogc:def:crs:EPSG::3857"}}}`))
+	if err != nil || space.SRIDOf(g.Geometry()) != space.PseudoMercator {
+		t.Errorf("urn crs not read: %v, %v", g, err)
+	}
+}
+
+func TestGeometryZM(t *testing.T) {
+	cases := []struct {
+		name string
+		geom space.Geometry
+		json string
+		want space.Geometry
+	}{
+		{name: "point z", geom: space.Point{1, 2, 3}, json: `[1,2,3]`, want: space.Point{1, 2, 3}},
+		{name: "linestring zm", geom: space.LineString{{1, 2, 3, 4}, {5, 6, 7, 8}}, json: `[[1,2,3,4],[5,6,7,8]]`,
+			want: space.LineString{{1, 2, 3, 4}, {5, 6, 7, 8}}},
+		{name: "point m", geom: space.CreatePointZM(1, 2, math.NaN(), 4), json: `[1,2,0,4]`, want: space.Point{1, 2, 0, 4}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(NewGeometry(tc.geom))
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+			if !strings.Contains(string(data), `"coordinates":`+tc.json) {
+				t.Errorf("incorrect coordinates: %s", data)
+			}
+			g, err := UnmarshalGeometry(data)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+			if got := g.Geometry(); !got.Equals(tc.want) || space.HasZ(got) != space.HasZ(tc.want) {
+				t.Errorf("incorrect geometry: %v != %v", got, tc.want)
+			}
+		})
+	}
+}
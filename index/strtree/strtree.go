@@ -0,0 +1,194 @@
+// Package strtree provides a query-only R-tree packed with the Sort-Tile-Recursive algorithm.
+package strtree
+
+import (
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+	"github.com/spatial-go/geoos/index"
+)
+
+// DefaultNodeCapacity is the default number of children of a node.
+const DefaultNodeCapacity = 10
+
+// Node is a node of the tree, a leaf node holds an item and an inner node holds its children.
+type Node struct {
+	Env      *envelope.Envelope
+	Children []*Node
+	Item     interface{}
+}
+
+// IsLeaf returns true if the node holds an item.
+func (n *Node) IsLeaf() bool {
+	return n.Children == nil
+}
+
+// STRtree A query-only R-tree created using the Sort-Tile-Recursive (STR) algorithm.
+// The tree is built on the first query, items inserted or removed after that rebuild it on the next query.
+// STR packing gives nodes of nearly full capacity and little overlap, for a static set of items.
+type STRtree struct {
+	NodeCapacity int
+	Root         *Node
+	items        []*Node
+}
+
+// NewSTRtree Constructs a STRtree with the node capacity, the default capacity if it is less than 2.
+func NewSTRtree(nodeCapacity int) *STRtree {
+	if nodeCapacity < 2 {
+		nodeCapacity = DefaultNodeCapacity
+	}
+	return &STRtree{NodeCapacity: nodeCapacity}
+}
+
+// Insert Adds a spatial item with an extent specified by the given Envelope to the tree.
+func (s *STRtree) Insert(itemEnv *envelope.Envelope, item interface{}) {
+	if itemEnv == nil || itemEnv.IsNil() {
+		return
+	}
+	s.items = append(s.items, &Node{Env: itemEnv, Item: item})
+	s.Root = nil
+}
+
+// Remove Removes a single item from the tree.
+func (s *STRtree) Remove(itemEnv *envelope.Envelope, item interface{}) bool {
+	for i, v := range s.items {
+		if sameItem(v.Item, item) && v.Env.Equals(itemEnv) {
+			s.items = append(s.items[:i], s.items[i+1:]...)
+			s.Root = nil
+			return true
+		}
+	}
+	return false
+}
+
+// Size Returns the number of items in the tree.
+func (s *STRtree) Size() int {
+	return len(s.items)
+}
+
+// IsEmpty Tests whether the tree contains any items.
+func (s *STRtree) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Depth Returns the number of levels in the tree, 0 for an empty tree.
+func (s *STRtree) Depth() int {
+	depth := 0
+	for n := s.Build(); n != nil && !n.IsLeaf(); n = n.Children[0] {
+		depth++
+	}
+	return depth
+}
+
+// Build Builds the tree if it is not built, and returns its root, nil for an empty tree.
+func (s *STRtree) Build() *Node {
+	if s.Root != nil || len(s.items) == 0 {
+		return s.Root
+	}
+	level := s.items
+	for {
+		level = s.packLevel(level)
+		if len(level) == 1 {
+			break
+		}
+	}
+	s.Root = level[0]
+	return s.Root
+}
+
+// packLevel returns the parent nodes of the nodes of a level, sorted into vertical slices by x and then by y.
+func (s *STRtree) packLevel(nodes []*Node) []*Node {
+	nodes = append([]*Node{}, nodes...)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Env.Centre()[0] < nodes[j].Env.Centre()[0]
+	})
+	leafCount := int(math.Ceil(float64(len(nodes)) / float64(s.NodeCapacity)))
+	sliceCount := int(math.Ceil(math.Sqrt(float64(leafCount))))
+	sliceCapacity := int(math.Ceil(float64(len(nodes)) / float64(sliceCount)))
+
+	parents := []*Node{}
+	for start := 0; start < len(nodes); start += sliceCapacity {
+		slice := nodes[start:minInt(start+sliceCapacity, len(nodes))]
+		sort.SliceStable(slice, func(i, j int) bool {
+			return slice[i].Env.Centre()[1] < slice[j].Env.Centre()[1]
+		})
+		for i := 0; i < len(slice); i += s.NodeCapacity {
+			children := append([]*Node{}, slice[i:minInt(i+s.NodeCapacity, len(slice))]...)
+			env := children[0].Env.Copy()
+			for _, v := range children[1:] {
+				env.ExpandToIncludeEnv(v.Env)
+			}
+			parents = append(parents, &Node{Env: env, Children: children})
+		}
+	}
+	return parents
+}
+
+// Query Queries the tree and returns items whose extents intersect the given search envelope.
+func (s *STRtree) Query(searchEnv *envelope.Envelope) []interface{} {
+	visitor := &index.ArrayVisitor{}
+	s.QueryVisitor(searchEnv, visitor)
+	return visitor.Items
+}
+
+// QueryVisitor Queries the tree and visits items whose extents intersect the given search envelope.
+func (s *STRtree) QueryVisitor(searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
+	if root := s.Build(); root != nil {
+		query(root, searchEnv, visitor)
+	}
+}
+
+func query(n *Node, searchEnv *envelope.Envelope, visitor index.ItemVisitor) {
+	if !n.Env.IsIntersects(searchEnv) {
+		return
+	}
+	if n.IsLeaf() {
+		visitor.VisitItem(n.Item)
+		return
+	}
+	for _, v := range n.Children {
+		query(v, searchEnv, visitor)
+	}
+}
+
+// ItemsTree Returns the items of the tree in the structure of its nodes,
+// each element is an item or a []interface{} of the items of a child node.
+func (s *STRtree) ItemsTree() []interface{} {
+	root := s.Build()
+	if root == nil {
+		return []interface{}{}
+	}
+	if root.IsLeaf() {
+		return []interface{}{root.Item}
+	}
+	return itemsTree(root)
+}
+
+func itemsTree(n *Node) []interface{} {
+	items := []interface{}{}
+	for _, v := range n.Children {
+		if v.IsLeaf() {
+			items = append(items, v.Item)
+		} else {
+			items = append(items, itemsTree(v))
+		}
+	}
+	return items
+}
+
+// sameItem returns true if the items are equal, items of types which are not comparable are deeply equal.
+func sameItem(a, b interface{}) bool {
+	if a == nil || b == nil || !reflect.TypeOf(a).Comparable() || !reflect.TypeOf(b).Comparable() {
+		return reflect.DeepEqual(a, b)
+	}
+	return a == b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
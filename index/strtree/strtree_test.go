@@ -0,0 +1,99 @@
+package strtree
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
+)
+
+func newGridTree(n int) *STRtree {
+	tree := NewSTRtree(4)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			x, y := float64(i), float64(j)
+			tree.Insert(envelope.FourFloat(x, x+0.5, y, y+0.5), i*n+j)
+		}
+	}
+	return tree
+}
+
+func TestSTRtree_Query(t *testing.T) {
+	tree := newGridTree(10)
+	tests := []struct {
+		name      string
+		searchEnv *envelope.Envelope
+		want      []int
+	}{
+		{"query one", envelope.FourFloat(0.1, 0.2, 0.1, 0.2), []int{0}},
+		{"query four", envelope.FourFloat(2.2, 3.2, 4.2, 5.2), []int{24, 25, 34, 35}},
+		{"query none", envelope.FourFloat(0.6, 0.9, 0.6, 0.9), []int{}},
+		{"query outside", envelope.FourFloat(20, 30, 20, 30), []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []int{}
+			for _, v := range tree.Query(tt.searchEnv) {
+				got = append(got, v.(int))
+			}
+			sort.Ints(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSTRtree_Build(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		wantSize  int
+		wantDepth int
+	}{
+		{"empty", 0, 0, 0},
+		{"one", 1, 1, 1},
+		{"grid", 10, 100, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := newGridTree(tt.n)
+			if got := tree.Size(); got != tt.wantSize {
+				t.Errorf("Size() = %v, want %v", got, tt.wantSize)
+			}
+			if got := tree.Depth(); got != tt.wantDepth {
+				t.Errorf("Depth() = %v, want %v", got, tt.wantDepth)
+			}
+			if got := countItems(tree.ItemsTree()); got != tt.wantSize {
+				t.Errorf("ItemsTree() has %v items, want %v", got, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestSTRtree_Remove(t *testing.T) {
+	tree := newGridTree(3)
+	_ = tree.Query(envelope.FourFloat(0, 3, 0, 3))
+	if !tree.Remove(envelope.FourFloat(1, 1.5, 1, 1.5), 4) {
+		t.Errorf("Remove() = false, want true")
+	}
+	if got := tree.Query(envelope.FourFloat(1.1, 1.2, 1.1, 1.2)); len(got) != 0 {
+		t.Errorf("Query() after Remove() = %v, want none", got)
+	}
+	if got := tree.Size(); got != 8 {
+		t.Errorf("Size() = %v, want 8", got)
+	}
+}
+
+func countItems(items []interface{}) int {
+	count := 0
+	for _, v := range items {
+		if child, ok := v.([]interface{}); ok {
+			count += countItems(child)
+		} else {
+			count++
+		}
+	}
+	return count
+}
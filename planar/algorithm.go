@@ -4,6 +4,8 @@ package planar
 import (
 	"errors"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/space"
 )
 
@@ -19,8 +21,16 @@ type Algorithm interface {
 
 	Buffer(geom space.Geometry, width float64, quadsegs int) space.Geometry
 
+	BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) (space.Geometry, error)
+
 	Centroid(geom space.Geometry) (space.Geometry, error)
 
+	ConcaveHull(geom space.Geometry, lengthRatio float64, holesAllowed bool) (space.Geometry, error)
+
+	ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, holesAllowed bool) (space.Geometry, error)
+
+	ConstrainedDelaunayTriangulation(geom space.Geometry) (space.Geometry, error)
+
 	Contains(geom1, geom2 space.Geometry) (bool, error)
 
 	ConvexHull(geom space.Geometry) (space.Geometry, error)
@@ -31,20 +41,30 @@ type Algorithm interface {
 
 	Crosses(geom1, geom2 space.Geometry) (bool, error)
 
+	DelaunayTriangulation(geom space.Geometry, tolerance float64, onlyEdges bool) (space.Geometry, error)
+
 	Difference(geom1, geom2 space.Geometry) (space.Geometry, error)
 
 	Disjoint(geom1, geom2 space.Geometry) (bool, error)
 
 	Distance(geom1, geom2 space.Geometry) (float64, error)
 
+	DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
 	SphericalDistance(geom1, geom2 space.Geometry) (float64, error)
 
+	SphericalDWithin(geom1, geom2 space.Geometry, distance float64) (bool, error)
+
 	Envelope(geom space.Geometry) (space.Geometry, error)
 
 	Equals(geom1, geom2 space.Geometry) (bool, error)
 
 	EqualsExact(geom1, geom2 space.Geometry, tolerance float64) (bool, error)
 
+	FrechetDistance(geom1, geom2 space.Geometry) (float64, error)
+
+	FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error)
+
 	HausdorffDistance(geom1, geom2 space.Geometry) (float64, error)
 
 	HausdorffDistanceDensify(s, d space.Geometry, densifyFrac float64) (float64, error)
@@ -61,26 +81,56 @@ type Algorithm interface {
 
 	IsSimple(geom space.Geometry) (bool, error)
 
+	IsValidReason(geom space.Geometry) (string, error)
+
+	IsValidDetail(geom space.Geometry) (*operation.ValidationError, error)
+
 	Length(geom space.Geometry) (float64, error)
 
 	LineMerge(geom space.Geometry) (space.Geometry, error)
 
+	MakeValid(geom space.Geometry) (space.Geometry, error)
+
+	MaximumInscribedCircle(geom space.Geometry, tolerance float64) (space.Point, float64, error)
+
+	MinimumBoundingCircle(geom space.Geometry) (space.Geometry, error)
+
+	MinimumBoundingRadius(geom space.Geometry) (space.Point, float64, error)
+
+	MinimumRotatedRectangle(geom space.Geometry) (space.Geometry, error)
+
+	MinimumWidth(geom space.Geometry) (space.Geometry, error)
+
+	NearestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error)
+
 	NGeometry(geom space.Geometry) (int, error)
 
+	Node(geom space.Geometry) (space.Geometry, error)
+
+	OffsetCurve(geom space.Geometry, distance float64, params *buffer.CurveParameters) (space.Geometry, error)
+
 	Overlaps(geom1, geom2 space.Geometry) (bool, error)
 
 	PointOnSurface(geom space.Geometry) (space.Geometry, error)
 
+	Polygonize(geom space.Geometry) (space.Geometry, error)
+
+	PolygonizeFull(geom space.Geometry) (polygons, dangles, cutEdges, invalidRings space.Geometry, err error)
+
 	Relate(s, d space.Geometry) (string, error)
 
 	SharedPaths(geom1, geom2 space.Geometry) (string, error)
 
+	ShortestLine(geom1, geom2 space.Geometry) (space.Geometry, error)
+
 	Simplify(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
 	SimplifyP(geom space.Geometry, tolerance float64) (space.Geometry, error)
 
 	Snap(input, reference space.Geometry, tolerance float64) (space.Geometry, error)
 
+	Split(input, blade space.Geometry) (space.Geometry, error)
+
 	SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error)
 
 	Touches(geom1, geom2 space.Geometry) (bool, error)
@@ -91,5 +141,7 @@ type Algorithm interface {
 
 	UniquePoints(geom space.Geometry) (space.Geometry, error)
 
+	VoronoiDiagram(sites space.Geometry, clip space.Bound, tolerance float64, onlyEdges bool) (space.Geometry, error)
+
 	Within(geom1, geom2 space.Geometry) (bool, error)
 }
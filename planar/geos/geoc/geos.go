@@ -12,6 +12,7 @@ import "C"
 import (
 	"errors"
 	"fmt"
+	"unsafe"
 )
 
 // GEOSContext ...
@@ -39,6 +40,14 @@ var (
 	geosContext = InitGeosContext()
 )
 
+// ErrUnsupported is returned by the functions which need a newer version of GEOS than the one built with.
+var ErrUnsupported = errors.New("not supported by this version of GEOS")
+
+// versionAtLeast returns true if the version of GEOS built with is at least major.minor.
+func versionAtLeast(major, minor int) bool {
+	return C.GEOS_VERSION_MAJOR > major || (C.GEOS_VERSION_MAJOR == major && C.GEOS_VERSION_MINOR >= minor)
+}
+
 // Area returns the area of a polygonal geometry
 func Area(wkt string) (float64, error) {
 	geoGeom := GeomFromWKTStr(wkt)
@@ -74,6 +83,30 @@ func Buffer(g string, width float64, quadsegs int32) (string, error) {
 	return ToWKTStr(bufferGeom)
 }
 
+// BufferWithParams returns a geometry that represents all points whose distance from
+// this Geometry is less than or equal to distance, with the end cap style, join style,
+// mitre limit and single sided flag of the buffer params.
+func BufferWithParams(g string, width float64, quadsegs, endCapStyle, joinStyle int32, mitreLimit float64, singleSided bool) (string, error) {
+	geom := GeomFromWKTStr(g)
+	params := C.GEOSBufferParams_create_r(geosContext)
+	side := 0
+	if singleSided {
+		side = 1
+	}
+	C.GEOSBufferParams_setQuadrantSegments_r(geosContext, params, C.int(quadsegs))
+	C.GEOSBufferParams_setEndCapStyle_r(geosContext, params, C.int(endCapStyle))
+	C.GEOSBufferParams_setJoinStyle_r(geosContext, params, C.int(joinStyle))
+	C.GEOSBufferParams_setMitreLimit_r(geosContext, params, C.double(mitreLimit))
+	C.GEOSBufferParams_setSingleSided_r(geosContext, params, C.int(side))
+	bufferGeom := C.GEOSBufferWithParams_r(geosContext, geom, params, C.double(width))
+	defer func() {
+		C.GEOSBufferParams_destroy_r(geosContext, params)
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, bufferGeom)
+	}()
+	return ToWKTStr(bufferGeom)
+}
+
 // Centroid Computes the geometric center of a geometry, or equivalently, the center of mass of the geometry as a POINT.
 // For [MULTI]POINTs, this is computed as the arithmetic mean of the input coordinates.
 // For [MULTI]LINESTRINGs, this is computed as the weighted length of each line segment.
@@ -92,6 +125,61 @@ func Centroid(wkt string) (string, error) {
 	return ToWKTStr(g)
 }
 
+// ConcaveHull returns the concave hull of the vertices of the geometry,
+// with the max edge length as a ratio between the shortest and the longest edge of the Delaunay triangulation.
+// It needs GEOS 3.11.
+func ConcaveHull(g string, lengthRatio float64, holesAllowed bool) (string, error) {
+	if !versionAtLeast(3, 11) {
+		return "", ErrUnsupported
+	}
+	geom := GeomFromWKTStr(g)
+	allowHoles := 0
+	if holesAllowed {
+		allowHoles = 1
+	}
+	hullGeom := C.GEOSConcaveHull_r(geosContext, geom, C.double(lengthRatio), C.uint(allowHoles))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, hullGeom)
+	}()
+	return ToWKTStr(hullGeom)
+}
+
+// ConcaveHullByLength returns the concave hull of the vertices of the geometry, with the max edge length.
+// It needs GEOS 3.12.
+func ConcaveHullByLength(g string, maxEdgeLength float64, holesAllowed bool) (string, error) {
+	if !versionAtLeast(3, 12) {
+		return "", ErrUnsupported
+	}
+	geom := GeomFromWKTStr(g)
+	allowHoles := 0
+	if holesAllowed {
+		allowHoles = 1
+	}
+	hullGeom := C.GEOSConcaveHullByLength_r(geosContext, geom, C.double(maxEdgeLength), C.uint(allowHoles))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, hullGeom)
+	}()
+	return ToWKTStr(hullGeom)
+}
+
+// ConstrainedDelaunayTriangulation returns the triangles of the constrained Delaunay triangulation of the geometry,
+// the segments of its lines and polygon rings are edges of the triangulation, as WKT strings.
+// It needs GEOS 3.10.
+func ConstrainedDelaunayTriangulation(g string) ([]string, error) {
+	if !versionAtLeast(3, 10) {
+		return nil, ErrUnsupported
+	}
+	geom := GeomFromWKTStr(g)
+	triGeom := C.GEOSConstrainedDelaunayTriangulation_r(geosContext, geom)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, triGeom)
+	}()
+	return ToWKTStrParts(triGeom)
+}
+
 // Contains Geometry A contains Geometry B if and only if no points of B lie in the exterior of A,
 // and at least one point of the interior of B lies in the interior of A.
 // An important subtlety of this definition is that A does not contain its boundary, but A does contain itself.
@@ -171,6 +259,22 @@ func Crosses(g1 string, g2 string) (bool, error) {
 	return boolFromC(c)
 }
 
+// DelaunayTriangulation returns the triangles of the Delaunay triangulation of the vertices of the geometry,
+// or its edges if onlyEdges is true, as WKT strings. Vertices closer than the tolerance are merged.
+func DelaunayTriangulation(g string, tolerance float64, onlyEdges bool) ([]string, error) {
+	geom := GeomFromWKTStr(g)
+	edges := 0
+	if onlyEdges {
+		edges = 1
+	}
+	triGeom := C.GEOSDelaunayTriangulation_r(geosContext, geom, C.double(tolerance), C.int(edges))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, triGeom)
+	}()
+	return ToWKTStrParts(triGeom)
+}
+
 // Difference returns a geometry that represents that part of geometry A that does not intersect with geometry B.
 // One can think of this as GeometryA - Intersection(A,B).
 // If A is completely contained in B then an empty geometry collection is returned.
@@ -214,6 +318,21 @@ func Distance(g1 string, g2 string) (float64, error) {
 	return float64(distance), nil
 }
 
+// DistanceWithin returns true if the distance between two geometries is not greater than the distance.
+// It needs GEOS 3.10.
+func DistanceWithin(g1 string, g2 string, distance float64) (bool, error) {
+	if !versionAtLeast(3, 10) {
+		return false, ErrUnsupported
+	}
+	geom1, geom2 := convertWKTtoGEOSGeometry(g1, g2)
+	c := C.GEOSDistanceWithin_r(geosContext, geom1, geom2, C.double(distance))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom1)
+		C.GEOSGeom_destroy_r(geosContext, geom2)
+	}()
+	return boolFromC(c)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -268,6 +387,31 @@ func HasZ(g string) (bool, error) {
 	return boolFromC(c)
 }
 
+// FrechetDistance returns the discrete Frechet distance between two geometries, a measure of how similar
+// 2 lines are which, unlike the Hausdorff distance, takes the order of the vertices into account.
+func FrechetDistance(g1 string, g2 string) (float64, error) {
+	geom1, geom2 := convertWKTtoGEOSGeometry(g1, g2)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom1)
+		C.GEOSGeom_destroy_r(geosContext, geom2)
+	}()
+	var distance C.double
+	c := C.GEOSFrechetDistance_r(geosContext, geom1, geom2, &distance)
+	return float64FromC(c, distance)
+}
+
+// FrechetDistanceDensify computes the Frechet distance with an additional densification fraction amount
+func FrechetDistanceDensify(g1 string, g2 string, densifyFrac float64) (float64, error) {
+	geom1, geom2 := convertWKTtoGEOSGeometry(g1, g2)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom1)
+		C.GEOSGeom_destroy_r(geosContext, geom2)
+	}()
+	var distance C.double
+	c := C.GEOSFrechetDistanceDensify_r(geosContext, geom1, geom2, C.double(densifyFrac), &distance)
+	return float64FromC(c, distance)
+}
+
 // HausdorffDistance returns the Hausdorff distance between two geometries, a measure of how similar or
 // dissimilar 2 geometries are. Implements algorithm for computing a distance metric which can be
 // thought of as the "Discrete Hausdorff Distance". This is the Hausdorff distance restricted to discrete points
@@ -360,6 +504,42 @@ func IsSimple(wkt string) (bool, error) {
 	return boolFromC(c)
 }
 
+// IsValidReason returns the reason the geometry is not valid with its location, or "Valid Geometry".
+func IsValidReason(wkt string) (string, error) {
+	geoGeom := GeomFromWKTStr(wkt)
+	defer C.GEOSGeom_destroy_r(geosContext, geoGeom)
+	c := C.GEOSisValidReason_r(geosContext, geoGeom)
+	if c == nil {
+		return "", Error()
+	}
+	defer C.GEOSFree_r(geosContext, unsafe.Pointer(c))
+	return C.GoString(c), nil
+}
+
+// IsValidDetail returns true if the geometry is valid, otherwise the reason and the location as WKT.
+func IsValidDetail(wkt string) (bool, string, string, error) {
+	geoGeom := GeomFromWKTStr(wkt)
+	defer C.GEOSGeom_destroy_r(geosContext, geoGeom)
+	var reason *C.char
+	var location *C.GEOSGeometry
+	c := C.GEOSisValidDetail_r(geosContext, geoGeom, 0, &reason, &location)
+	if c == 2 {
+		return false, "", "", Error()
+	}
+	if c == 1 {
+		return true, "", "", nil
+	}
+	defer func() {
+		C.GEOSFree_r(geosContext, unsafe.Pointer(reason))
+		C.GEOSGeom_destroy_r(geosContext, location)
+	}()
+	loc, err := ToWKTStr(location)
+	if err != nil {
+		return false, "", "", err
+	}
+	return false, C.GoString(reason), loc, nil
+}
+
 // Length returns the 2D Cartesian length of the geometry if it is a LineString, MultiLineString
 func Length(wkt string) (float64, error) {
 	geoGeom := GeomFromWKTStr(wkt)
@@ -386,6 +566,17 @@ func LineMerge(wkt string) (string, error) {
 	return ToWKTStr(g)
 }
 
+// Node returns the linework of the geometry fully noded.
+func Node(wkt string) (string, error) {
+	geoGeom := GeomFromWKTStr(wkt)
+	g := C.GEOSNode_r(geosContext, geoGeom)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geoGeom)
+		C.GEOSGeom_destroy_r(geosContext, g)
+	}()
+	return ToWKTStr(g)
+}
+
 // NGeometry returns the number of component geometries.
 func NGeometry(g string) (int, error) {
 	geom := GeomFromWKTStr(g)
@@ -394,6 +585,18 @@ func NGeometry(g string) (int, error) {
 	return intFromC(c, -1)
 }
 
+// OffsetCurve returns the line displaced by the width to the left, or to the right for a negative width,
+// with the quadrant segments, join style and mitre limit of the joins.
+func OffsetCurve(g string, width float64, quadsegs, joinStyle int32, mitreLimit float64) (string, error) {
+	geom := GeomFromWKTStr(g)
+	offsetGeom := C.GEOSOffsetCurve_r(geosContext, geom, C.double(width), C.int(quadsegs), C.int(joinStyle), C.double(mitreLimit))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, offsetGeom)
+	}()
+	return ToWKTStr(offsetGeom)
+}
+
 // Overlaps returns true if one geometry overlaps the other.
 func Overlaps(g1 string, g2 string) (bool, error) {
 	geom1, geom2 := convertWKTtoGEOSGeometry(g1, g2)
@@ -418,6 +621,105 @@ func PointOnSurface(wkt string) (string, error) {
 	return ToWKTStr(g)
 }
 
+// PolygonizeFull returns the polygons formed by the linework of the geometry,
+// and its cut edges, dangles and invalid rings.
+func PolygonizeFull(g string) (polygons, cutEdges, dangles, invalidRings []string, err error) {
+	geom := GeomFromWKTStr(g)
+	var cuts, dangleGeom, invalid *C.GEOSGeometry
+	result := C.GEOSPolygonize_full_r(geosContext, geom, &cuts, &dangleGeom, &invalid)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, result)
+		C.GEOSGeom_destroy_r(geosContext, cuts)
+		C.GEOSGeom_destroy_r(geosContext, dangleGeom)
+		C.GEOSGeom_destroy_r(geosContext, invalid)
+	}()
+	if result == nil {
+		return nil, nil, nil, nil, errors.New("Polygonize return null")
+	}
+	if polygons, err = ToWKTStrParts(result); err != nil {
+		return
+	}
+	if cutEdges, err = ToWKTStrParts(cuts); err != nil {
+		return
+	}
+	if dangles, err = ToWKTStrParts(dangleGeom); err != nil {
+		return
+	}
+	invalidRings, err = ToWKTStrParts(invalid)
+	return
+}
+
+// MakeValid returns a valid geometry which keeps all the area of the geometry.
+func MakeValid(wkt string) (string, error) {
+	geoGeom := GeomFromWKTStr(wkt)
+	g := C.GEOSMakeValid_r(geosContext, geoGeom)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geoGeom)
+		C.GEOSGeom_destroy_r(geosContext, g)
+	}()
+	if g == nil {
+		return "", Error()
+	}
+	return ToWKTStr(g)
+}
+
+// MaximumInscribedCircle returns the line from the center of the largest circle inside the polygon
+// to the nearest point of the boundary, the center is found within the tolerance.
+func MaximumInscribedCircle(g string, tolerance float64) (string, error) {
+	geom := GeomFromWKTStr(g)
+	line := C.GEOSMaximumInscribedCircle_r(geosContext, geom, C.double(tolerance))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, line)
+	}()
+	return ToWKTStr(line)
+}
+
+// MinimumBoundingCircle returns the smallest circle polygon which contains the geometry, its center and its radius.
+func MinimumBoundingCircle(g string) (string, string, float64, error) {
+	geom := GeomFromWKTStr(g)
+	var radius C.double
+	var center *C.GEOSGeometry
+	circle := C.GEOSMinimumBoundingCircle_r(geosContext, geom, &radius, &center)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, circle)
+		C.GEOSGeom_destroy_r(geosContext, center)
+	}()
+	if circle == nil || center == nil {
+		return "", "", 0, errors.New("MinimumBoundingCircle return null")
+	}
+	circleStr, err := ToWKTStr(circle)
+	if err != nil {
+		return "", "", 0, err
+	}
+	centerStr, err := ToWKTStr(center)
+	return circleStr, centerStr, float64(radius), err
+}
+
+// MinimumRotatedRectangle returns the rectangle of the smallest area which contains the geometry.
+func MinimumRotatedRectangle(g string) (string, error) {
+	geom := GeomFromWKTStr(g)
+	rect := C.GEOSMinimumRotatedRectangle_r(geosContext, geom)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, rect)
+	}()
+	return ToWKTStr(rect)
+}
+
+// MinimumWidth returns the line of the minimum width of the geometry.
+func MinimumWidth(g string) (string, error) {
+	geom := GeomFromWKTStr(g)
+	width := C.GEOSMinimumWidth_r(geosContext, geom)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		C.GEOSGeom_destroy_r(geosContext, width)
+	}()
+	return ToWKTStr(width)
+}
+
 // Relate computes the intersection matrix (Dimensionally Extended
 // Nine-Intersection Model (DE-9IM) matrix) for the spatial relationship between
 // the two geometries.
@@ -448,6 +750,23 @@ func SharedPaths(g1 string, g2 string) (string, error) {
 	return ToWKTStr(g)
 }
 
+// ShortestLine returns the line joining the nearest points of two geometries, from g1 to g2.
+func ShortestLine(g1 string, g2 string) (string, error) {
+	geom1, geom2 := convertWKTtoGEOSGeometry(g1, g2)
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom1)
+		C.GEOSGeom_destroy_r(geosContext, geom2)
+	}()
+	seq := C.GEOSNearestPoints_r(geosContext, geom1, geom2)
+	if seq == nil {
+		return "", Error()
+	}
+	// the line takes the ownership of the coordinate sequence.
+	line := C.GEOSGeom_createLineString_r(geosContext, seq)
+	defer C.GEOSGeom_destroy_r(geosContext, line)
+	return ToWKTStr(line)
+}
+
 // Simplify returns a "simplified" version of the given geometry using the Douglas-Peucker algorithm,
 // May not preserve topology
 func Simplify(wkt string, tolerance float64) (string, error) {
@@ -558,6 +877,33 @@ func Version() string {
 	return C.GoString(C.GEOSversion())
 }
 
+// VoronoiDiagram returns the cells of the Voronoi diagram of the vertices of the geometry in the order of the vertices,
+// or its edges if onlyEdges is true, as WKT strings. The diagram covers at least the clip envelope if it is not empty,
+// vertices closer than the tolerance are merged.
+func VoronoiDiagram(g, env string, tolerance float64, onlyEdges bool) ([]string, error) {
+	geom := GeomFromWKTStr(g)
+	var envGeom GEOSGeometry
+	if env != "" {
+		envGeom = GeomFromWKTStr(env)
+	}
+	flags := C.GEOS_VORONOI_PRESERVE_ORDER
+	if onlyEdges {
+		flags = C.GEOS_VORONOI_ONLY_EDGES
+	}
+	voronoiGeom := C.GEOSVoronoiDiagram_r(geosContext, geom, envGeom, C.double(tolerance), C.int(flags))
+	defer func() {
+		C.GEOSGeom_destroy_r(geosContext, geom)
+		if envGeom != nil {
+			C.GEOSGeom_destroy_r(geosContext, envGeom)
+		}
+		C.GEOSGeom_destroy_r(geosContext, voronoiGeom)
+	}()
+	if voronoiGeom == nil {
+		return nil, errors.New("VoronoiDiagram return null")
+	}
+	return ToWKTStrParts(voronoiGeom)
+}
+
 // Within returns TRUE if geometry A is completely inside geometry B.
 // For this function to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
@@ -8,3 +8,29 @@ void geos_notice_handler(const char *fmt, ...);
 void geos_error_handler(const char *fmt, ...);
 char *geos_get_last_error(void);
 GEOSContextHandle_t geos_initGEOS();
+
+#define GEOS_VERSION_AT_LEAST(major, minor) \
+    (GEOS_VERSION_MAJOR > (major) || (GEOS_VERSION_MAJOR == (major) && GEOS_VERSION_MINOR >= (minor)))
+
+/* stubs of the functions missing in older GEOS, the Go functions return ErrUnsupported before calling them. */
+#if !GEOS_VERSION_AT_LEAST(3, 10)
+static inline GEOSGeometry *GEOSConstrainedDelaunayTriangulation_r(GEOSContextHandle_t handle, const GEOSGeometry *g) {
+    return NULL;
+}
+
+static inline char GEOSDistanceWithin_r(GEOSContextHandle_t handle, const GEOSGeometry *g1, const GEOSGeometry *g2, double dist) {
+    return 2;
+}
+#endif
+
+#if !GEOS_VERSION_AT_LEAST(3, 11)
+static inline GEOSGeometry *GEOSConcaveHull_r(GEOSContextHandle_t handle, const GEOSGeometry *g, double ratio, unsigned int allowHoles) {
+    return NULL;
+}
+#endif
+
+#if !GEOS_VERSION_AT_LEAST(3, 12)
+static inline GEOSGeometry *GEOSConcaveHullByLength_r(GEOSContextHandle_t handle, const GEOSGeometry *g, double length, unsigned int allowHoles) {
+    return NULL;
+}
+#endif
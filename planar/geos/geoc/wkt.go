@@ -23,6 +23,25 @@ func ToWKTStr(g GEOSGeometry) (string, error) {
 	return DecodeWKTToStr(w, g)
 }
 
+// ToWKTStrParts convert the component geometries of GEOSGeometry to WKT strings
+func ToWKTStrParts(g GEOSGeometry) ([]string, error) {
+	w := WKTWriterFactory()
+	defer WKTWriterDestroy(w)
+	n := int(C.GEOSGetNumGeometries_r(geosContext, g))
+	if n < 0 {
+		return nil, errors.New("number of geometries is invalid")
+	}
+	parts := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		part, err := DecodeWKTToStr(w, C.GEOSGetGeometryN_r(geosContext, g, C.int(i)))
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, part)
+	}
+	return parts, nil
+}
+
 // GeomFromWKTStr convert WKT string to GEOSGeometry
 func GeomFromWKTStr(wktstr string) GEOSGeometry {
 	reader := WKTReaderFactory()
@@ -3,10 +3,15 @@ package geos
 import (
 	"sync"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/planar"
 	"github.com/spatial-go/geoos/planar/geos/geoc"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 var algorithmGeos, algorithmMegrez planar.Algorithm
@@ -52,6 +57,21 @@ func (g *GEOAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs int)
 	return
 }
 
+// BufferWithParams returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// with the end cap style, join style, mitre limit and single sided flag of the params, the default params if nil.
+func (g *GEOAlgorithm) BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	if params == nil || params.IsEmpty() {
+		params = buffer.DefaultCurveParameters()
+	}
+	result, err := geoc.BufferWithParams(wkt.MarshalString(geom), width, int32(params.QuadrantSegments),
+		int32(params.EndCapStyle), int32(params.JoinStyle), params.MitreLimit, params.IsSingleSided)
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
 // Centroid  computes the geometric center of a geometry, or equivalently, the center of mass of the geometry as a POINT.
 // For [MULTI]POINTs, this is computed as the arithmetic mean of the input coordinates.
 // For [MULTI]LINESTRINGs, this is computed as the weighted length of each line segment.
@@ -72,6 +92,53 @@ func (g *GEOAlgorithm) Centroid(geom space.Geometry) (space.Geometry, error) {
 	return geometry, nil
 }
 
+// ConcaveHull returns the concave hull of the vertices of the geometry, a polygon which contains all the vertices,
+// the length ratio between 0 and 1 sets the max edge length between the shortest and the longest edge
+// of the Delaunay triangulation of the vertices, 1 gives the convex hull.
+// If holes are allowed the polygon may have holes.
+// It falls back to the Megrez implementation with GEOS older than 3.11.
+func (g *GEOAlgorithm) ConcaveHull(geom space.Geometry, lengthRatio float64, holesAllowed bool) (space.Geometry, error) {
+	result, err := geoc.ConcaveHull(wkt.MarshalString(geom), lengthRatio, holesAllowed)
+	if err == geoc.ErrUnsupported {
+		return planar.NormalStrategy().ConcaveHull(geom, lengthRatio, holesAllowed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
+// ConcaveHullByLength returns the concave hull of the vertices of the geometry, a polygon which contains all the vertices
+// and has no edge longer than the max edge length, unless it is needed to keep the hull a single polygon.
+// If holes are allowed the polygon may have holes.
+// It falls back to the Megrez implementation with GEOS older than 3.12.
+func (g *GEOAlgorithm) ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, holesAllowed bool) (space.Geometry, error) {
+	result, err := geoc.ConcaveHullByLength(wkt.MarshalString(geom), maxEdgeLength, holesAllowed)
+	if err == geoc.ErrUnsupported {
+		return planar.NormalStrategy().ConcaveHullByLength(geom, maxEdgeLength, holesAllowed)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
+// ConstrainedDelaunayTriangulation returns the constrained Delaunay triangulation of the vertices of the geometry,
+// the segments of its lines and polygon rings are edges of the triangulation,
+// for a geometry with polygons only the triangles inside the polygons are returned.
+// The result is a GeometryCollection of triangle polygons.
+// It falls back to the Megrez implementation with GEOS older than 3.10.
+func (g *GEOAlgorithm) ConstrainedDelaunayTriangulation(geom space.Geometry) (space.Geometry, error) {
+	parts, err := geoc.ConstrainedDelaunayTriangulation(wkt.MarshalString(geom))
+	if err == geoc.ErrUnsupported {
+		return planar.NormalStrategy().ConstrainedDelaunayTriangulation(geom)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalParts(parts)
+}
+
 // Contains space.Geometry A contains space.Geometry B if and only if no points of B lie in the exterior of A,
 // and at least one point of the interior of B lies in the interior of A.
 // An important subtlety of this definition is that A does not contain its boundary, but A does contain itself.
@@ -119,6 +186,20 @@ func (g *GEOAlgorithm) Crosses(geom1, geom2 space.Geometry) (bool, error) {
 	return geoc.Crosses(ms1, ms2)
 }
 
+// DelaunayTriangulation returns the Delaunay triangulation of the vertices of the geometry,
+// vertices closer than the tolerance are merged.
+// The result is a GeometryCollection of triangle polygons, or a MultiLineString of the edges if onlyEdges is true.
+func (g *GEOAlgorithm) DelaunayTriangulation(geom space.Geometry, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	parts, err := geoc.DelaunayTriangulation(wkt.MarshalString(geom), tolerance, onlyEdges)
+	if err != nil {
+		return nil, err
+	}
+	if !onlyEdges {
+		return unmarshalParts(parts)
+	}
+	return unmarshalLines(parts)
+}
+
 // Difference returns a geometry that represents that part of geometry A that does not intersect with geometry B.
 // One can think of this as GeometryA - Intersection(A,B).
 // If A is completely contained in B then an empty geometry collection is returned.
@@ -155,6 +236,23 @@ func (g *GEOAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float64,
 	return geom1.SpheroidDistance(geom2)
 }
 
+// DWithin returns true if the geometries are within the distance of each other, in projected units.
+// It falls back to the Megrez implementation with GEOS older than 3.10.
+func (g *GEOAlgorithm) DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	ms1, ms2 := convertGeomToWKT(geom1, geom2)
+	within, err := geoc.DistanceWithin(ms1, ms2, distance)
+	if err == geoc.ErrUnsupported {
+		return planar.NormalStrategy().DWithin(geom1, geom2, distance)
+	}
+	return within, err
+}
+
+// SphericalDWithin returns true if the lon/lat geometries are within the distance in m of each other.
+// GEOS only computes planar distances, the result is computed by the normal strategy.
+func (g *GEOAlgorithm) SphericalDWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	return planar.NormalStrategy().SphericalDWithin(geom1, geom2, distance)
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -179,6 +277,47 @@ func (g *GEOAlgorithm) EqualsExact(geom1, geom2 space.Geometry, tolerance float6
 	return geoc.EqualsExact(ms1, ms2, tolerance)
 }
 
+// FrechetDistance returns the discrete Frechet distance between two geometries, a measure of how similar
+// 2 lines are which, unlike the Hausdorff distance, takes the order of the vertices into account.
+func (g *GEOAlgorithm) FrechetDistance(geom1, geom2 space.Geometry) (float64, error) {
+	ms1, ms2 := convertGeomToWKT(geom1, geom2)
+	return geoc.FrechetDistance(ms1, ms2)
+}
+
+// FrechetDistanceDensify computes the Frechet distance with an additional densification fraction amount
+func (g *GEOAlgorithm) FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	ms1, ms2 := convertGeomToWKT(geom1, geom2)
+	return geoc.FrechetDistanceDensify(ms1, ms2, densifyFrac)
+}
+
+// NearestPoints returns the points of the two geometries which are nearest to each other,
+// the first on geom1 and the second on geom2, nil points if a geometry is empty.
+func (g *GEOAlgorithm) NearestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error) {
+	line, err := g.ShortestLine(geom1, geom2)
+	if err != nil || line == nil {
+		return nil, nil, err
+	}
+	ls := line.(space.LineString)
+	return space.Point(ls[0]), space.Point(ls[1]), nil
+}
+
+// ShortestLine returns the line joining the nearest points of two geometries, from geom1 to geom2,
+// nil if a geometry is empty.
+func (g *GEOAlgorithm) ShortestLine(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	if geom1 == nil || geom2 == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if geom1.IsEmpty() || geom2.IsEmpty() {
+		return nil, nil
+	}
+	ms1, ms2 := convertGeomToWKT(geom1, geom2)
+	result, err := geoc.ShortestLine(ms1, ms2)
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
 // HausdorffDistance returns the Hausdorff distance between two geometries, a measure of how similar
 // or dissimilar 2 geometries are. Implements algorithm for computing a distance metric which can be
 // thought of as the "Discrete Hausdorff Distance". This is the Hausdorff distance restricted
@@ -237,6 +376,26 @@ func (g *GEOAlgorithm) IsSimple(geom space.Geometry) (bool, error) {
 	return geoc.IsSimple(wkt.MarshalString(geom))
 }
 
+// IsValidReason returns the reason the geometry is not valid with its location, e.g. Self-intersection[1 1],
+// or "Valid Geometry" if it is valid.
+func (g *GEOAlgorithm) IsValidReason(geom space.Geometry) (string, error) {
+	return geoc.IsValidReason(wkt.MarshalString(geom))
+}
+
+// IsValidDetail returns why the geometry is not valid as OGC defines it and where, nil if it is valid.
+func (g *GEOAlgorithm) IsValidDetail(geom space.Geometry) (*operation.ValidationError, error) {
+	valid, reason, location, err := geoc.IsValidDetail(wkt.MarshalString(geom))
+	if err != nil || valid {
+		return nil, err
+	}
+	loc, err := wkt.UnmarshalString(location)
+	if err != nil {
+		return nil, err
+	}
+	pt, _ := loc.(space.Point)
+	return &operation.ValidationError{Kind: operation.ValidationKindOf(reason), Location: matrix.Matrix(pt)}, nil
+}
+
 // Length returns the 2D Cartesian length of the geometry if it is a LineString, MultiLineString
 func (g *GEOAlgorithm) Length(geom space.Geometry) (float64, error) {
 	return geoc.Length(wkt.MarshalString(geom))
@@ -251,11 +410,98 @@ func (g *GEOAlgorithm) LineMerge(geom space.Geometry) (space.Geometry, error) {
 	return wkt.UnmarshalString(result)
 }
 
+// MakeValid returns a valid geometry which keeps all the area of the geometry.
+func (g *GEOAlgorithm) MakeValid(geom space.Geometry) (space.Geometry, error) {
+	result, err := geoc.MakeValid(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
+// MaximumInscribedCircle returns the center and the radius of the largest circle inside the polygon.
+func (g *GEOAlgorithm) MaximumInscribedCircle(geom space.Geometry, tolerance float64) (space.Point, float64, error) {
+	result, err := geoc.MaximumInscribedCircle(wkt.MarshalString(geom), tolerance)
+	if err != nil {
+		return nil, 0, err
+	}
+	line, err := wkt.UnmarshalString(result)
+	if err != nil {
+		return nil, 0, err
+	}
+	// the line runs from the center to the nearest point of the boundary
+	radius := line.(space.LineString)
+	return space.Point(radius[0]), measure.PlanarDistance(radius[0], radius[len(radius)-1]), nil
+}
+
+// MinimumBoundingCircle returns the smallest circle polygon which contains all the vertices of the geometry.
+func (g *GEOAlgorithm) MinimumBoundingCircle(geom space.Geometry) (space.Geometry, error) {
+	circle, _, _, err := geoc.MinimumBoundingCircle(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(circle)
+}
+
+// MinimumBoundingRadius returns the center and the radius of the smallest circle which contains all the vertices of the geometry.
+func (g *GEOAlgorithm) MinimumBoundingRadius(geom space.Geometry) (space.Point, float64, error) {
+	_, center, radius, err := geoc.MinimumBoundingCircle(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, 0, err
+	}
+	point, err := wkt.UnmarshalString(center)
+	if err != nil {
+		return nil, 0, err
+	}
+	return point.(space.Point), radius, nil
+}
+
+// MinimumRotatedRectangle returns the rectangle of the smallest area which contains all the vertices of the geometry.
+func (g *GEOAlgorithm) MinimumRotatedRectangle(geom space.Geometry) (space.Geometry, error) {
+	result, err := geoc.MinimumRotatedRectangle(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
+// MinimumWidth returns the minimum width of the geometry as a line, its length is the width.
+func (g *GEOAlgorithm) MinimumWidth(geom space.Geometry) (space.Geometry, error) {
+	result, err := geoc.MinimumWidth(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
 // NGeometry returns the number of component geometries.
 func (g *GEOAlgorithm) NGeometry(geom space.Geometry) (int, error) {
 	return geoc.NGeometry(wkt.MarshalString(geom))
 }
 
+// Node returns a MultiLineString of the linework of the geometry fully noded.
+func (g *GEOAlgorithm) Node(geom space.Geometry) (space.Geometry, error) {
+	result, err := geoc.Node(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
+// OffsetCurve returns the line displaced by the distance to the left, or to the right for a negative distance,
+// with the join style and mitre limit of the params, the default params if nil.
+func (g *GEOAlgorithm) OffsetCurve(geom space.Geometry, distance float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	if params == nil || params.IsEmpty() {
+		params = buffer.DefaultCurveParameters()
+	}
+	result, err := geoc.OffsetCurve(wkt.MarshalString(geom), distance, int32(params.QuadrantSegments),
+		int32(params.JoinStyle), params.MitreLimit)
+	if err != nil {
+		return nil, err
+	}
+	return wkt.UnmarshalString(result)
+}
+
 // Overlaps returns TRUE if the Geometries "spatially overlap".
 // By that we mean they intersect, but one does not completely contain another.
 func (g *GEOAlgorithm) Overlaps(geom1, geom2 space.Geometry) (bool, error) {
@@ -272,6 +518,33 @@ func (g *GEOAlgorithm) PointOnSurface(geom space.Geometry) (space.Geometry, erro
 	return wkt.UnmarshalString(result)
 }
 
+// Polygonize returns a GeometryCollection of the polygons formed by the linework of the geometry.
+func (g *GEOAlgorithm) Polygonize(geom space.Geometry) (space.Geometry, error) {
+	polygons, _, _, _, err := g.PolygonizeFull(geom)
+	return polygons, err
+}
+
+// PolygonizeFull returns the polygons formed by the linework of the geometry, its dangles, cut edges and invalid rings.
+func (g *GEOAlgorithm) PolygonizeFull(geom space.Geometry) (polygons, dangles, cutEdges, invalidRings space.Geometry, err error) {
+	polygonParts, cutParts, dangleParts, invalidParts, err := geoc.PolygonizeFull(wkt.MarshalString(geom))
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if polygons, err = unmarshalParts(polygonParts); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if dangles, err = unmarshalLines(dangleParts); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if cutEdges, err = unmarshalLines(cutParts); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	if invalidRings, err = unmarshalLines(invalidParts); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return polygons, dangles, cutEdges, invalidRings, nil
+}
+
 // Relate computes the intersection matrix (Dimensionally Extended
 // Nine-Intersection Model (DE-9IM) matrix) for the spatial relationship between
 // the two geometries.
@@ -331,6 +604,12 @@ func (g *GEOAlgorithm) Snap(input, reference space.Geometry, tolerance float64)
 	return geometry, nil
 }
 
+// Split returns a GeometryCollection of the pieces of the input cut by the blade.
+// The C API of GEOS has no split, the pieces are computed by the normal strategy.
+func (g *GEOAlgorithm) Split(input, blade space.Geometry) (space.Geometry, error) {
+	return planar.NormalStrategy().Split(input, blade)
+}
+
 // SymDifference returns a geometry that represents the portions of A and B that do not intersect.
 // It is called a symmetric difference because SymDifference(A,B) = SymDifference(B,A).
 // One can think of this as Union(geomA,geomB) - Intersection(A,B).
@@ -393,6 +672,55 @@ func (g *GEOAlgorithm) UniquePoints(geom space.Geometry) (space.Geometry, error)
 
 }
 
+// VoronoiDiagram returns the Voronoi diagram of the vertices of the sites, clipped to the clip bound,
+// vertices closer than the tolerance are merged into one site.
+// The result is a GeometryCollection with a polygon per vertex of the sites in their order,
+// or a MultiLineString of the edges if onlyEdges is true.
+func (g *GEOAlgorithm) VoronoiDiagram(sites space.Geometry, clip space.Bound, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	env := ""
+	if !clip.IsEmpty() {
+		env = wkt.MarshalString(clip.ToPolygon())
+	}
+	parts, err := geoc.VoronoiDiagram(wkt.MarshalString(sites), env, tolerance, onlyEdges)
+	if err != nil {
+		return nil, err
+	}
+	if onlyEdges {
+		result := space.MultiLineString{}
+		for _, v := range parts {
+			line, err := wkt.UnmarshalString(v)
+			if err != nil {
+				return nil, err
+			}
+			if env != "" {
+				if line, err = g.Intersection(line, clip.ToPolygon()); err != nil {
+					return nil, err
+				}
+			}
+			if l, ok := line.(space.LineString); ok && len(l) > 1 {
+				result = append(result, l)
+			}
+		}
+		return result, nil
+	}
+	cells, err := unmarshalParts(parts)
+	if err != nil || env == "" {
+		return cells, err
+	}
+	result := space.Collection{}
+	for _, v := range cells.(space.Collection) {
+		cell, err := g.Intersection(v, clip.ToPolygon())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := cell.(space.Polygon); !ok {
+			cell = space.Polygon{}
+		}
+		result = append(result, cell)
+	}
+	return result, nil
+}
+
 // Within returns TRUE if geometry A is completely inside geometry B.
 // For this function to make sense, the source geometries must both be of the same coordinate projection,
 // having the same SRID.
@@ -407,3 +735,29 @@ func convertGeomToWKT(geom1, geom2 space.Geometry) (string, string) {
 	ms2 := wkt.MarshalString(geom2)
 	return ms1, ms2
 }
+
+// unmarshalParts help to convert the WKT strings of the component geometries to a geometry collection.
+func unmarshalParts(parts []string) (space.Geometry, error) {
+	result := space.Collection{}
+	for _, v := range parts {
+		geometry, err := wkt.UnmarshalString(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, geometry)
+	}
+	return result, nil
+}
+
+// unmarshalLines help to convert the WKT strings of the lines to a MultiLineString.
+func unmarshalLines(parts []string) (space.Geometry, error) {
+	result := space.MultiLineString{}
+	for _, v := range parts {
+		line, err := wkt.UnmarshalString(v)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, line.(space.LineString))
+	}
+	return result, nil
+}
@@ -1,7 +1,9 @@
 package planar
 
 import (
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // MegrezAlgorithm algorithm implement
@@ -41,3 +43,46 @@ func (g *MegrezAlgorithm) IsRing(geom space.Geometry) (bool, error) {
 func (g *MegrezAlgorithm) IsSimple(geom space.Geometry) (bool, error) {
 	return geom.IsSimple(), nil
 }
+
+// IsValidReason returns the reason the geometry is not valid with its location, e.g. Self-intersection[1 1],
+// or "Valid Geometry" if it is valid.
+func (g *MegrezAlgorithm) IsValidReason(geom space.Geometry) (string, error) {
+	detail, err := g.IsValidDetail(geom)
+	if err != nil {
+		return "", err
+	}
+	if detail == nil {
+		return operation.ValidGeometry, nil
+	}
+	return detail.Error(), nil
+}
+
+// MakeValid returns a valid geometry which keeps all the area of the geometry.
+// Self-intersecting rings are split at the intersections, wrongly nested holes become shells or islands,
+// repeated and non-finite coordinates are removed and overlapping parts of a MultiPolygon are dissolved.
+// Rings which collapse to lines or points are returned with the polygons in a Collection.
+func (g *MegrezAlgorithm) MakeValid(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	vop := &operation.ValidOP{Steric: geom.ToMatrix()}
+	switch geom.(type) {
+	case space.MultiPolygon:
+		return space.TransGeometry(vop.MakeValidMultiPolygon()), nil
+	case space.Bound, space.Ring:
+		return geom, nil
+	}
+	return space.TransGeometry(vop.MakeValid()), nil
+}
+
+// IsValidDetail returns why the geometry is not valid as OGC defines it and where, nil if it is valid.
+func (g *MegrezAlgorithm) IsValidDetail(geom space.Geometry) (*operation.ValidationError, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	vop := &operation.ValidOP{Steric: geom.ToMatrix()}
+	if _, ok := geom.(space.MultiPolygon); ok {
+		return vop.ValidateMultiPolygon(), nil
+	}
+	return vop.Validate(), nil
+}
@@ -3,6 +3,7 @@ package planar
 import (
 	"github.com/spatial-go/geoos/algorithm/measure"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Area returns the area of a polygonal geometry.
@@ -28,6 +29,41 @@ func (g *MegrezAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float6
 	return geom1.SpheroidDistance(geom2)
 }
 
+// DWithin returns true if the geometries are within the distance of each other, in projected units.
+// The facets are compared only if their envelopes are close enough, up to the first pair within the distance.
+// It returns false if a geometry is empty.
+func (g *MegrezAlgorithm) DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	if geom1 == nil || geom2 == nil {
+		return false, spaceerr.ErrNilGeometry
+	}
+	return measure.IsWithinDistance(geom1.ToMatrix(), geom2.ToMatrix(), distance), nil
+}
+
+// SphericalDWithin returns true if the lon/lat geometries are within the distance in m of each other.
+func (g *MegrezAlgorithm) SphericalDWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	if geom1 == nil || geom2 == nil {
+		return false, spaceerr.ErrNilGeometry
+	}
+	return measure.IsWithinSpheroidDistance(geom1.ToMatrix(), geom2.ToMatrix(), distance), nil
+}
+
+// FrechetDistance returns the discrete Frechet distance between two geometries, a measure of how similar
+// 2 lines are which, unlike the Hausdorff distance, takes the order of the vertices into account.
+func (g *MegrezAlgorithm) FrechetDistance(geom1, geom2 space.Geometry) (float64, error) {
+	if geom1 == nil || geom2 == nil {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	return measure.FrechetDistance(geom1.ToMatrix(), geom2.ToMatrix()), nil
+}
+
+// FrechetDistanceDensify computes the Frechet distance with an additional densification fraction amount
+func (g *MegrezAlgorithm) FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	if geom1 == nil || geom2 == nil {
+		return 0, spaceerr.ErrNilGeometry
+	}
+	return measure.FrechetDistanceDensify(geom1.ToMatrix(), geom2.ToMatrix(), densifyFrac)
+}
+
 // HausdorffDistance returns the Hausdorff distance between two geometries, a measure of how similar
 // or dissimilar 2 geometries are. Implements algorithm for computing a distance metric which can be
 // thought of as the "Discrete Hausdorff Distance". This is the Hausdorff distance restricted
@@ -41,6 +77,25 @@ func (g *MegrezAlgorithm) HausdorffDistanceDensify(geom1, geom2 space.Geometry,
 	return (&measure.HausdorffDistance{}).DistanceDensifyFrac(geom1.ToMatrix(), geom2.ToMatrix(), densifyFrac)
 }
 
+// NearestPoints returns the points of the two geometries which are nearest to each other,
+// the first on geom1 and the second on geom2, nil points if a geometry is empty.
+func (g *MegrezAlgorithm) NearestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error) {
+	return space.NearestPoints(geom1, geom2, measure.PlanarDistance)
+}
+
+// ShortestLine returns the line joining the nearest points of two geometries, from geom1 to geom2,
+// nil if a geometry is empty.
+func (g *MegrezAlgorithm) ShortestLine(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return shortestLine(g.NearestPoints(geom1, geom2))
+}
+
+func shortestLine(p1, p2 space.Point, err error) (space.Geometry, error) {
+	if err != nil || p1 == nil {
+		return nil, err
+	}
+	return space.LineString{p1, p2}, nil
+}
+
 // Length returns the 2D Cartesian length of the geometry if it is a LineString, MultiLineString
 func (g *MegrezAlgorithm) Length(geom space.Geometry) (float64, error) {
 	return geom.Length(), nil
@@ -1,6 +1,7 @@
 package planar
 
 import (
+	"math"
 	"testing"
 
 	"github.com/spatial-go/geoos/encoding/wkt"
@@ -203,6 +204,44 @@ func TestAlgorithm_HausdorffDistance(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_FrechetDistance(t *testing.T) {
+	line, _ := wkt.UnmarshalString(`LINESTRING (0 0, 100 0)`)
+	bent, _ := wkt.UnmarshalString(`LINESTRING (0 0, 50 50, 100 0)`)
+	reversed, _ := wkt.UnmarshalString(`LINESTRING (100 0, 0 0)`)
+	tests := []struct {
+		name        string
+		g1, g2      space.Geometry
+		densifyFrac float64
+		want        float64
+		wantErr     bool
+	}{
+		{name: "frechet", g1: line, g2: bent, want: 70.71067811865476},
+		{name: "frechet reversed", g1: line, g2: reversed, want: 100},
+		{name: "frechet densify", g1: line, g2: bent, densifyFrac: 0.5, want: 50},
+		{name: "frechet wrong fraction", g1: line, g2: bent, densifyFrac: 2, wantErr: true},
+		{name: "frechet nil", g1: line, g2: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			var got float64
+			var err error
+			if tt.densifyFrac == 0 {
+				got, err = G.FrechetDistance(tt.g1, tt.g2)
+			} else {
+				got, err = G.FrechetDistanceDensify(tt.g1, tt.g2, tt.densifyFrac)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FrechetDistance() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("FrechetDistance() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestMegrezAlgorithm_HausdorffDistanceDensify(t *testing.T) {
 
 	g3 := "LINESTRING (130 0, 0 0, 0 150)"
@@ -251,3 +290,74 @@ func TestMegrezAlgorithm_HausdorffDistanceDensify(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_NearestPoints(t *testing.T) {
+	G := NormalStrategy()
+	line, _ := wkt.UnmarshalString(`LINESTRING (0 0, 10 0)`)
+	poly, _ := wkt.UnmarshalString(`POLYGON ((12 -1, 14 -1, 14 1, 12 1, 12 -1))`)
+	p1, p2, err := G.NearestPoints(line, poly)
+	if err != nil || !p1.Equals(space.Point{10, 0}) || !p2.Equals(space.Point{12, 0}) {
+		t.Errorf("NearestPoints() = %v %v %v", p1, p2, err)
+	}
+	shortest, err := G.ShortestLine(poly, line)
+	if err != nil || !shortest.Equals(space.LineString{{12, 0}, {10, 0}}) {
+		t.Errorf("ShortestLine() = %v %v", shortest, err)
+	}
+	if _, _, err := G.NearestPoints(line, nil); err == nil {
+		t.Errorf("NearestPoints() error = nil for a nil geometry")
+	}
+	if shortest, err := G.ShortestLine(line, space.LineString{}); shortest != nil || err != nil {
+		t.Errorf("ShortestLine() = %v %v, want nil for an empty geometry", shortest, err)
+	}
+
+	// a degree of longitude is shorter than a degree of latitude away from the equator.
+	pt, points := space.Point{0, 60}, space.MultiPoint{{10, 60}, {0, 67}}
+	if _, p2, _ := G.NearestPoints(pt, points); !p2.Equals(space.Point{0, 67}) {
+		t.Errorf("NearestPoints() planar = %v, want POINT (0 67)", p2)
+	}
+	wgs84 := space.WithSRID(pt, space.WGS84)
+	if _, p2, _ := G.NearestPoints(wgs84, space.WithSRID(points, space.WGS84)); !p2.Equals(space.Point{10, 60}) {
+		t.Errorf("NearestPoints() geodesic = %v, want POINT (10 60)", p2)
+	}
+	shortest, err = G.ShortestLine(wgs84, points)
+	if err != nil || space.SRIDOf(shortest) != space.WGS84 || !space.Unwrap(shortest).Equals(space.LineString{{0, 60}, {10, 60}}) {
+		t.Errorf("ShortestLine() geodesic = %v %v", shortest, err)
+	}
+}
+
+func TestAlgorithm_DWithin(t *testing.T) {
+	G := NormalStrategy()
+	line, _ := wkt.UnmarshalString(`LINESTRING (0 0, 10 0)`)
+	poly, _ := wkt.UnmarshalString(`POLYGON ((12 -1, 14 -1, 14 1, 12 1, 12 -1))`)
+	tests := []struct {
+		name     string
+		distance float64
+		want     bool
+	}{
+		{name: "within", distance: 2, want: true},
+		{name: "beyond", distance: 1.9, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.DWithin(line, poly, tt.distance)
+			if err != nil || got != tt.want {
+				t.Errorf("DWithin() = %v %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+	if _, err := G.DWithin(line, nil, 1); err == nil {
+		t.Errorf("DWithin() error = nil for a nil geometry")
+	}
+
+	// 10 degrees of longitude at latitude 60 are about 556 km.
+	pt, east := space.Point{0, 60}, space.Point{10, 60}
+	if within, _ := G.SphericalDWithin(pt, east, 600000); !within {
+		t.Errorf("SphericalDWithin() = false, want true")
+	}
+	if within, err := G.DWithin(space.WithSRID(pt, space.WGS84), space.WithSRID(east, space.WGS84), 600000); err != nil || !within {
+		t.Errorf("DWithin() geodesic = %v %v, want true", within, err)
+	}
+	if within, _ := G.DWithin(space.WithSRID(pt, space.WGS84), east, 500000); within {
+		t.Errorf("DWithin() geodesic = true, want false")
+	}
+}
@@ -1,7 +1,6 @@
 package planar
 
 import (
-	"github.com/spatial-go/geoos/algorithm/algoerr"
 	"github.com/spatial-go/geoos/algorithm/matrix"
 	"github.com/spatial-go/geoos/algorithm/overlay"
 	"github.com/spatial-go/geoos/algorithm/sharedpaths"
@@ -14,44 +13,12 @@ import (
 // One can think of this as GeometryA - Intersection(A,B).
 // If A is completely contained in B then an empty geometry collection is returned.
 func (g *MegrezAlgorithm) Difference(geom1, geom2 space.Geometry) (space.Geometry, error) {
-	if geom1.GeoJSONType() != geom1.GeoJSONType() {
-		return nil, algoerr.ErrNotMatchType
-	}
-	var err error
-	if result, err := overlay.Difference(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
-		return space.TransGeometry(result), nil
-	}
-	return nil, err
+	return nodedOverlay(geom1, geom2, overlay.OpDifference)
 }
 
 // Intersection returns a geometry that represents the point set intersection of the Geometries.
-func (g *MegrezAlgorithm) Intersection(geom1, geom2 space.Geometry) (intersectGeom space.Geometry, intersectErr error) {
-	switch geom1.GeoJSONType() {
-	case space.TypePoint:
-		over := &overlay.PointOverlay{Subject: geom1.ToMatrix(), Clipping: geom2.ToMatrix()}
-		if result, err := over.Intersection(); err == nil {
-			intersectGeom = space.TransGeometry(result)
-		} else {
-			intersectErr = err
-		}
-	case space.TypeLineString:
-		over := &overlay.LineOverlay{PointOverlay: &overlay.PointOverlay{Subject: geom1.ToMatrix(), Clipping: geom2.ToMatrix()}}
-		if result, err := over.Intersection(); err == nil {
-			intersectGeom = space.TransGeometry(result)
-		} else {
-			intersectErr = err
-		}
-	case space.TypePolygon:
-		over := &overlay.PolygonOverlay{PointOverlay: &overlay.PointOverlay{Subject: geom1.ToMatrix(), Clipping: geom2.ToMatrix()}}
-		if result, err := over.Intersection(); err != nil {
-			intersectGeom = space.TransGeometry(result)
-		} else {
-			intersectErr = err
-		}
-	default:
-		intersectErr = algoerr.ErrNotMatchType
-	}
-	return
+func (g *MegrezAlgorithm) Intersection(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	return nodedOverlay(geom1, geom2, overlay.OpIntersection)
 }
 
 // LineMerge returns a (set of) LineString(s) formed by sewing together the constituent line work of a MULTILINESTRING.
@@ -68,6 +35,50 @@ func (g *MegrezAlgorithm) LineMerge(geom space.Geometry) (space.Geometry, error)
 	return lm, nil
 }
 
+// Node returns a MultiLineString of the linework of the geometry fully noded,
+// the lines are split at all their intersections and self intersections, without duplicate segments.
+func (g *MegrezAlgorithm) Node(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result := space.MultiLineString{}
+	for _, v := range overlay.Node(geom.ToMatrix()) {
+		result = append(result, space.LineString(v))
+	}
+	return result, nil
+}
+
+// Polygonize returns a GeometryCollection of the polygons formed by the linework of the geometry,
+// which must be correctly noded. See PolygonizeFull.
+func (g *MegrezAlgorithm) Polygonize(geom space.Geometry) (space.Geometry, error) {
+	polygons, _, _, _, err := g.PolygonizeFull(geom)
+	return polygons, err
+}
+
+// PolygonizeFull returns a GeometryCollection of the polygons formed by the linework of the geometry,
+// which must be correctly noded, the lines may only meet at their vertices.
+// The lines which are not part of a polygon are returned as MultiLineStrings:
+// the dangles, with an end not joined to other lines, the cut edges, joined at both ends but not on the boundary of a polygon,
+// and the invalid rings, which cross themselves.
+func (g *MegrezAlgorithm) PolygonizeFull(geom space.Geometry) (polygons, dangles, cutEdges, invalidRings space.Geometry, err error) {
+	if geom == nil {
+		return nil, nil, nil, nil, spaceerr.ErrNilGeometry
+	}
+	result := overlay.Polygonize(geom.ToMatrix())
+	coll := space.Collection{}
+	for _, v := range result.Polygons {
+		coll = append(coll, space.Polygon(v))
+	}
+	lines := func(ls []matrix.LineMatrix) space.MultiLineString {
+		ml := space.MultiLineString{}
+		for _, v := range ls {
+			ml = append(ml, space.LineString(v))
+		}
+		return ml
+	}
+	return coll, lines(result.Dangles), lines(result.CutEdges), lines(result.InvalidRings), nil
+}
+
 // SharedPaths returns a collection containing paths shared by the two input geometries.
 // Those going in the same direction are in the first element of the collection,
 // those going in the opposite direction are in the second element.
@@ -90,40 +101,48 @@ func (g *MegrezAlgorithm) SharedPaths(geom1, geom2 space.Geometry) (string, erro
 	return wkt.MarshalString(coll), nil
 }
 
+// Split returns a GeometryCollection of the pieces of the input cut by the blade.
+// A LineString is split by points or lines, a Polygon or MultiPolygon by lines, each hole is kept in its piece.
+// If the blade does not split the input, the input is the only piece.
+func (g *MegrezAlgorithm) Split(input, blade space.Geometry) (space.Geometry, error) {
+	if input == nil || blade == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if input.Dimensions() == 0 {
+		return nil, spaceerr.ErrNotSupportGeometry
+	}
+	result := space.Collection{}
+	for _, v := range overlay.Split(input.ToMatrix(), blade.ToMatrix()) {
+		result = append(result, space.TransGeometry(v))
+	}
+	return result, nil
+}
+
 // SymDifference returns a geometry that represents the portions of A and B that do not intersect.
 // It is called a symmetric difference because SymDifference(A,B) = SymDifference(B,A).
 // One can think of this as Union(geomA,geomB) - Intersection(A,B).
 func (g *MegrezAlgorithm) SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error) {
-	if geom1.GeoJSONType() != geom1.GeoJSONType() {
-		return nil, algoerr.ErrNotMatchType
-	}
-	var err error
-	if result, err := overlay.SymDifference(geom1.ToMatrix(), geom2.ToMatrix()); err == nil {
-		return space.TransGeometry(result), nil
-	}
-	return nil, err
+	return nodedOverlay(geom1, geom2, overlay.OpSymDifference)
 }
 
 // UnaryUnion does dissolve boundaries between components of a multipolygon (invalid) and does perform union
 // between the components of a geometrycollection
 func (g *MegrezAlgorithm) UnaryUnion(geom space.Geometry) (space.Geometry, error) {
-	if geom.GeoJSONType() == space.TypeMultiPolygon {
-		result := overlay.UnaryUnion(geom.ToMatrix())
-		return space.Polygon(result.(matrix.PolygonMatrix)), nil
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
 	}
-	return nil, ErrNotPolygon
+	return space.TransGeometry(overlay.UnaryUnion(geom.ToMatrix())), nil
 }
 
 // Union returns a new geometry representing all points in this geometry and the other.
 func (g *MegrezAlgorithm) Union(geom1, geom2 space.Geometry) (space.Geometry, error) {
-	if geom1.GeoJSONType() == space.TypePolygon && geom2.GeoJSONType() == space.TypePolygon {
-		result := overlay.Union(matrix.PolygonMatrix(geom1.(space.Polygon)), matrix.PolygonMatrix(geom2.(space.Polygon)))
-		return space.Polygon(result.(matrix.PolygonMatrix)), nil
-	} else if geom1.GeoJSONType() == space.TypePoint && geom2.GeoJSONType() == space.TypePoint {
-		return space.MultiPoint{geom1.(space.Point), geom2.(space.Point)}, nil
-	} else if geom1.GeoJSONType() == space.TypeLineString && geom2.GeoJSONType() == space.TypeLineString {
-		result := overlay.UnionLine(geom1.ToMatrix().(matrix.LineMatrix), geom2.ToMatrix().(matrix.LineMatrix))
-		return space.TransGeometry(result), nil
+	return nodedOverlay(geom1, geom2, overlay.OpUnion)
+}
+
+// nodedOverlay returns the overlay of the geometries of any type, see overlay.NodedOverlay.
+func nodedOverlay(geom1, geom2 space.Geometry, op overlay.Op) (space.Geometry, error) {
+	if geom1 == nil || geom2 == nil {
+		return nil, spaceerr.ErrNilGeometry
 	}
-	return space.Collection{geom1, geom2}, nil
+	return space.TransGeometry(overlay.NodedOverlay(geom1.ToMatrix(), geom2.ToMatrix(), op)), nil
 }
@@ -1,6 +1,7 @@
 package planar
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
@@ -45,6 +46,12 @@ func TestAlgorithm_Intersection(t *testing.T) {
 	point02, _ := wkt.UnmarshalString(`POINT(0 0)`)
 	line02, _ := wkt.UnmarshalString(`LINESTRING ( 0 0, 0 2 )`)
 	expectPoint, _ := wkt.UnmarshalString(`POINT(0 0)`)
+	point01, _ := wkt.UnmarshalString(`POINT(20 20)`)
+	line01, _ := wkt.UnmarshalString(`LINESTRING(-5 5,15 5)`)
+	expectLine, _ := wkt.UnmarshalString(`LINESTRING(0 5,10 5)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	multiPolygon, _ := wkt.UnmarshalString(`MULTIPOLYGON(((-5 -5,5 -5,5 5,-5 5,-5 -5),(-1 -1,1 -1,1 1,-1 1,-1 -1)),((8 8,12 8,12 12,8 12,8 8)))`)
+	expectMultiPolygon, _ := wkt.UnmarshalString(`MULTIPOLYGON(((5 0,5 5,0 5,0 1,1 1,1 0,5 0)),((8 8,10 8,10 10,8 10,8 8)))`)
 
 	type args struct {
 		g1 space.Geometry
@@ -57,8 +64,11 @@ func TestAlgorithm_Intersection(t *testing.T) {
 		wantErr bool
 	}{
 		{name: "intersection", args: args{g1: point02, g2: line02}, want: expectPoint, wantErr: false},
-		{name: "intersection error", args: args{g1: space.Collection{point02}, g2: space.Collection{line02}}, want: nil, wantErr: true},
-		{name: "intersection error", args: args{g1: point02, g2: space.Collection{line02}}, want: nil, wantErr: true},
+		{name: "intersection collection", args: args{g1: space.Collection{point02}, g2: space.Collection{line02}}, want: expectPoint, wantErr: false},
+		{name: "intersection collection", args: args{g1: point02, g2: space.Collection{line02}}, want: expectPoint, wantErr: false},
+		{name: "intersection multi polygon", args: args{g1: multiPolygon, g2: polygon}, want: expectMultiPolygon, wantErr: false},
+		{name: "intersection mixed", args: args{g1: line01, g2: polygon}, want: expectLine, wantErr: false},
+		{name: "intersection empty", args: args{g1: polygon, g2: point01}, want: space.Point{}, wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -82,6 +92,9 @@ func TestAlgorithm_LineMerge(t *testing.T) {
 	multiLineString1, _ := wkt.UnmarshalString(`MULTILINESTRING((-29 -27,-30 -29.7,-36 -31,-45 -33),(-45.2 -33.2,-46 -32))`)
 	expectMultiLineString, _ := wkt.UnmarshalString(`MULTILINESTRING((-29 -27,-30 -29.7,-36 -31,-45 -33),(-45.2 -33.2,-46 -32))`)
 
+	multiLineString2, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,1 0),(5 5,6 5),(1 0,2 0))`)
+	expectMultiLineString2, _ := wkt.UnmarshalString(`MULTILINESTRING((5 5,6 5),(0 0,1 0,2 0))`)
+
 	type args struct {
 		g space.Geometry
 	}
@@ -93,6 +106,7 @@ func TestAlgorithm_LineMerge(t *testing.T) {
 	}{
 		{name: "LineMerge Point", args: args{g: multiLineString0}, want: expectLine0, wantErr: false},
 		{name: "LineMerge LineString0", args: args{g: multiLineString1}, want: expectMultiLineString, wantErr: false},
+		{name: "LineMerge three lines", args: args{g: multiLineString2}, want: expectMultiLineString2, wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -111,6 +125,92 @@ func TestAlgorithm_LineMerge(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_Node(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0),(5 -5,5 5),(0 0,5 0))`)
+	want, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,5 0),(5 0,10 0),(5 -5,5 0),(5 0,5 5))`)
+	G := NormalStrategy()
+	got, err := G.Node(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.EqualsExact(want, 0.000001) {
+		t.Errorf("Node() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(want))
+	}
+	if _, err := G.Node(nil); err == nil {
+		t.Errorf("Node() nil geometry error = nil")
+	}
+}
+
+func TestAlgorithm_Polygonize(t *testing.T) {
+	lines, _ := wkt.UnmarshalString(`MULTILINESTRING((0 0,10 0,10 5,10 10,0 10,0 0),(10 5,15 5),(15 5,20 5,20 0,15 0,15 5),(2 2,2 4,4 4,4 2,2 2),(0 0,-5 -5))`)
+	G := NormalStrategy()
+	polygons, dangles, cutEdges, invalidRings, err := G.PolygonizeFull(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := G.NGeometry(polygons); n != 3 {
+		t.Errorf("PolygonizeFull() polygons = %v", wkt.MarshalString(polygons))
+	}
+	area := 0.0
+	for _, v := range polygons.(space.Collection) {
+		a, _ := G.Area(v)
+		area += a
+	}
+	if area != 100+25 {
+		t.Errorf("PolygonizeFull() area = %v, want 125", area)
+	}
+	if want := (space.MultiLineString{{{0, 0}, {-5, -5}}}); !dangles.Equals(want) {
+		t.Errorf("PolygonizeFull() dangles = %v", wkt.MarshalString(dangles))
+	}
+	if want := (space.MultiLineString{{{10, 5}, {15, 5}}}); !cutEdges.Equals(want) {
+		t.Errorf("PolygonizeFull() cut edges = %v", wkt.MarshalString(cutEdges))
+	}
+	if invalidRings.(space.MultiLineString) == nil || len(invalidRings.(space.MultiLineString)) != 0 {
+		t.Errorf("PolygonizeFull() invalid rings = %v", wkt.MarshalString(invalidRings))
+	}
+	if _, err := G.Polygonize(nil); err == nil {
+		t.Errorf("Polygonize() nil geometry error = nil")
+	}
+}
+
+func TestAlgorithm_Split(t *testing.T) {
+	parcel, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0),(6 6,6 8,8 8,8 6,6 6))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(0 0,10 0)`)
+	tests := []struct {
+		name  string
+		input space.Geometry
+		blade space.Geometry
+		areas []float64
+		want  space.Geometry
+	}{
+		{name: "polygon by line", input: parcel, blade: space.LineString{{4, -1}, {4, 11}}, areas: []float64{40, 56}},
+		{name: "line by point", input: line, blade: space.Point{4, 0},
+			want: space.Collection{space.LineString{{0, 0}, {4, 0}}, space.LineString{{4, 0}, {10, 0}}}},
+		{name: "line by line", input: line, blade: space.LineString{{5, -5}, {5, 5}},
+			want: space.Collection{space.LineString{{0, 0}, {5, 0}}, space.LineString{{5, 0}, {10, 0}}}},
+	}
+	G := NormalStrategy()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := G.Split(tt.input, tt.blade)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.want != nil && !got.Equals(tt.want) {
+				t.Errorf("Split() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+			for i, area := range tt.areas {
+				if a, _ := G.Area(got.(space.Collection)[i]); math.Abs(a-area) > 1e-9 {
+					t.Errorf("Split() piece %v area = %v, want %v", i, a, area)
+				}
+			}
+		})
+	}
+	if _, err := G.Split(space.Point{0, 0}, line); err == nil {
+		t.Errorf("Split() point error = nil")
+	}
+}
+
 func TestAlgorithm_SymDifference(t *testing.T) {
 	line01, _ := wkt.UnmarshalString(`LINESTRING(50 100, 50 200)`)
 	line02, _ := wkt.UnmarshalString(`LINESTRING(50 50, 50 150)`)
@@ -184,7 +284,11 @@ func TestAlgorithm_SharedPaths(t *testing.T) {
 
 func TestAlgorithm_UnaryUnion(t *testing.T) {
 	multiPolygon, _ := wkt.UnmarshalString(`MULTIPOLYGON(((0 0, 10 0, 10 10, 0 10, 0 0)), ((5 5, 15 5, 15 15, 5 15, 5 5)))`)
-	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((5 10,0 10,0 0,10 0,10 5,15 5,15 15,5 15,5 10))`)
+	expectPolygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 5,15 5,15 15,5 15,5 10,0 10,0 0))`)
+	line, _ := wkt.UnmarshalString(`LINESTRING(5 -5,5 5)`)
+	point, _ := wkt.UnmarshalString(`POINT(20 20)`)
+	lineOut, _ := wkt.UnmarshalString(`LINESTRING(5 -5,5 0)`)
+	expectCollection := space.Collection{expectPolygon, lineOut, point}
 	type args struct {
 		g space.Geometry
 	}
@@ -195,6 +299,7 @@ func TestAlgorithm_UnaryUnion(t *testing.T) {
 		wantErr bool
 	}{
 		{name: "UnaryUnion Polygon", args: args{g: multiPolygon}, want: []space.Geometry{expectPolygon}, wantErr: false},
+		{name: "UnaryUnion Collection", args: args{g: space.Collection{multiPolygon, line, point}}, want: []space.Geometry{expectCollection}, wantErr: false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -213,6 +318,37 @@ func TestAlgorithm_UnaryUnion(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_UnionBuffers(t *testing.T) {
+	G := NormalStrategy()
+	for _, quadsegs := range []int{8, 2} {
+		point, line := space.Point{0, 0}, space.LineString{{0, 0}, {10, 0}}
+		union, _ := G.Union(G.Buffer(point, 1, quadsegs), G.Buffer(line, 1, quadsegs))
+		tests := []struct {
+			name string
+			got  space.Geometry
+		}{
+			{name: "union", got: union},
+			{name: "buffer collection", got: G.Buffer(space.Collection{point, line}, 1, quadsegs)},
+		}
+		// the left half of the point buffer and the right cap of the line buffer.
+		wantArea := 20 + 2*float64(quadsegs)*math.Sin(math.Pi/float64(2*quadsegs))
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if reason, _ := G.IsValidReason(tt.got); reason != "Valid Geometry" {
+					t.Errorf("Union() quadsegs %v is invalid, %v", quadsegs, reason)
+				}
+				polygon, ok := tt.got.(space.Polygon)
+				if !ok || len(polygon) != 1 || len(polygon[0]) != 4*quadsegs+3 {
+					t.Errorf("Union() quadsegs %v got = %v", quadsegs, wkt.MarshalString(tt.got))
+				}
+				if area, _ := G.Area(tt.got); math.Abs(area-wantArea) > 1e-9 {
+					t.Errorf("Union() quadsegs %v area = %v, want %v", quadsegs, area, wantArea)
+				}
+			})
+		}
+	}
+}
+
 func TestAlgorithm_Union(t *testing.T) {
 	point01, _ := wkt.UnmarshalString(`POINT(1 2)`)
 	point02, _ := wkt.UnmarshalString(`POINT(-2 3)`)
@@ -222,6 +358,11 @@ func TestAlgorithm_Union(t *testing.T) {
 	line02, _ := wkt.UnmarshalString(`LINESTRING(50 50, 50 150)`)
 	expectMultiline, _ := wkt.UnmarshalString(`MULTILINESTRING((50 100,50 150),(50 150,50 200),(50 50,50 100))`)
 
+	point03, _ := wkt.UnmarshalString(`POINT(20 5)`)
+	polygon, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0))`)
+	polygonHole, _ := wkt.UnmarshalString(`POLYGON((0 0,10 0,10 10,0 10,0 0),(4 4,6 4,6 6,4 6,4 4))`)
+	hole, _ := wkt.UnmarshalString(`POLYGON((4 4,6 4,6 6,4 6,4 4))`)
+
 	type args struct {
 		g1 space.Geometry
 		g2 space.Geometry
@@ -234,6 +375,8 @@ func TestAlgorithm_Union(t *testing.T) {
 	}{
 		{name: "union", args: args{g1: point01, g2: point02}, want: expectMultiPoint},
 		{name: "union line", args: args{g1: line01, g2: line02}, want: expectMultiline},
+		{name: "union mixed", args: args{g1: point03, g2: polygon}, want: space.Collection{polygon, point03}},
+		{name: "union polygon hole", args: args{g1: polygonHole, g2: hole}, want: polygon},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -3,9 +3,11 @@ package planar
 import (
 	"github.com/spatial-go/geoos/algorithm/buffer"
 	"github.com/spatial-go/geoos/algorithm/buffer/simplify"
-	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/matrix/envelope"
 	"github.com/spatial-go/geoos/algorithm/overlay/snap"
+	"github.com/spatial-go/geoos/algorithm/triangulate"
 	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
 )
 
 // Boundary returns the closure of the combinatorial boundary of this space.Geometry.
@@ -15,15 +17,31 @@ func (g *MegrezAlgorithm) Boundary(geom space.Geometry) (space.Geometry, error)
 
 // Buffer sReturns a geometry that represents all points whose distance
 // from this space.Geometry is less than or equal to distance.
+// The buffers of the elements of a multi geometry or a collection are unioned,
+// a negative width erodes polygons, an empty polygon is returned if nothing is left.
 func (g *MegrezAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs int) (geometry space.Geometry) {
+	if geom == nil {
+		return nil
+	}
 	buff := buffer.Buffer(geom.ToMatrix(), width, quadsegs)
-	switch b := buff.(type) {
-	case matrix.LineMatrix:
-		return space.LineString(b)
-	case matrix.PolygonMatrix:
-		return space.Polygon(b)
+	if buff == nil {
+		return space.Polygon{}
 	}
-	return nil
+	return space.TransGeometry(buff)
+}
+
+// BufferWithParams returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance,
+// with the end cap style, join style, mitre limit and single sided flag of the params, the default params if nil.
+func (g *MegrezAlgorithm) BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	buff := buffer.BufferWithParams(geom.ToMatrix(), width, params)
+	if buff == nil {
+		return space.Polygon{}, nil
+	}
+	return space.TransGeometry(buff), nil
 }
 
 // Centroid  computes the geometric center of a geometry, or equivalently, the center of mass of the geometry as a POINT.
@@ -41,6 +59,42 @@ func (g *MegrezAlgorithm) Centroid(geom space.Geometry) (space.Geometry, error)
 	return space.Centroid(geom), nil
 }
 
+// ConcaveHull returns the concave hull of the vertices of the geometry, a polygon which contains all the vertices,
+// the length ratio between 0 and 1 sets the max edge length between the shortest and the longest edge
+// of the Delaunay triangulation of the vertices, 1 gives the convex hull.
+// If holes are allowed the polygon may have holes.
+func (g *MegrezAlgorithm) ConcaveHull(geom space.Geometry, lengthRatio float64, holesAllowed bool) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(buffer.ConcaveHull(geom.ToMatrix(), lengthRatio, holesAllowed)), nil
+}
+
+// ConcaveHullByLength returns the concave hull of the vertices of the geometry, a polygon which contains all the vertices
+// and has no edge longer than the max edge length, unless it is needed to keep the hull a single polygon.
+// If holes are allowed the polygon may have holes.
+func (g *MegrezAlgorithm) ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, holesAllowed bool) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(buffer.ConcaveHullByLength(geom.ToMatrix(), maxEdgeLength, holesAllowed)), nil
+}
+
+// ConstrainedDelaunayTriangulation returns the constrained Delaunay triangulation of the vertices of the geometry,
+// the segments of its lines and polygon rings are edges of the triangulation,
+// for a geometry with polygons only the triangles inside the polygons are returned.
+// The result is a GeometryCollection of triangle polygons.
+func (g *MegrezAlgorithm) ConstrainedDelaunayTriangulation(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	result := space.Collection{}
+	for _, v := range triangulate.ConstrainedDelaunayTriangles(geom.ToMatrix()) {
+		result = append(result, space.Polygon(v))
+	}
+	return result, nil
+}
+
 // ConvexHull computes the convex hull of a geometry. The convex hull is the smallest convex geometry
 // that encloses all geometries in the input.
 // In the general case the convex hull is a Polygon.
@@ -51,6 +105,27 @@ func (g *MegrezAlgorithm) ConvexHull(geom space.Geometry) (space.Geometry, error
 	return space.TransGeometry(result), nil
 }
 
+// DelaunayTriangulation returns the Delaunay triangulation of the vertices of the geometry,
+// vertices closer than the tolerance are merged.
+// The result is a GeometryCollection of triangle polygons, or a MultiLineString of the edges if onlyEdges is true.
+func (g *MegrezAlgorithm) DelaunayTriangulation(geom space.Geometry, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	if onlyEdges {
+		result := space.MultiLineString{}
+		for _, v := range triangulate.DelaunayEdges(geom.ToMatrix(), tolerance) {
+			result = append(result, space.LineString(v))
+		}
+		return result, nil
+	}
+	result := space.Collection{}
+	for _, v := range triangulate.DelaunayTriangles(geom.ToMatrix(), tolerance) {
+		result = append(result, space.Polygon(v))
+	}
+	return result, nil
+}
+
 // Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
 // The polygon is defined by the corner points of the bounding box
 // ((MINX, MINY), (MINX, MAXY), (MAXX, MAXY), (MAXX, MINY), (MINX, MINY)).
@@ -63,6 +138,89 @@ func (g *MegrezAlgorithm) Envelope(geom space.Geometry) (space.Geometry, error)
 	}
 }
 
+// MaximumInscribedCircle returns the center and the radius of the largest circle inside the polygon,
+// its center is the interior point farthest from the boundary, found within the tolerance.
+// For a multi polygon the part with the largest circle is chosen.
+func (g *MegrezAlgorithm) MaximumInscribedCircle(geom space.Geometry, tolerance float64) (space.Point, float64, error) {
+	if geom == nil {
+		return nil, 0, spaceerr.ErrNilGeometry
+	}
+	if geom.Dimensions() != 2 {
+		return nil, 0, spaceerr.ErrNotPolygon
+	}
+	center, radius := buffer.MaximumInscribedCircle(geom.ToMatrix(), tolerance)
+	if center == nil {
+		return nil, 0, nil
+	}
+	return space.Point(center), radius, nil
+}
+
+// MinimumBoundingCircle returns the smallest circle polygon which contains all the vertices of the geometry,
+// a point if the geometry has a single vertex and an empty polygon if it is empty.
+func (g *MegrezAlgorithm) MinimumBoundingCircle(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	center, radius := buffer.MinimumBoundingCircle(geom.ToMatrix())
+	switch {
+	case center == nil:
+		return space.Polygon{}, nil
+	case radius == 0:
+		return space.Point(center), nil
+	}
+	return space.TransGeometry(buffer.Buffer(center, radius, 8)), nil
+}
+
+// MinimumBoundingRadius returns the center and the radius of the smallest circle which contains all the vertices of the geometry,
+// a nil center if it is empty.
+func (g *MegrezAlgorithm) MinimumBoundingRadius(geom space.Geometry) (space.Point, float64, error) {
+	if geom == nil {
+		return nil, 0, spaceerr.ErrNilGeometry
+	}
+	center, radius := buffer.MinimumBoundingCircle(geom.ToMatrix())
+	if center == nil {
+		return nil, 0, nil
+	}
+	return space.Point(center), radius, nil
+}
+
+// MinimumRotatedRectangle returns the rectangle of the smallest area which contains all the vertices of the geometry,
+// rotated to the direction of an edge of the convex hull, or the convex hull if the vertices are collinear.
+func (g *MegrezAlgorithm) MinimumRotatedRectangle(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	return space.TransGeometry(buffer.MinimumRotatedRectangle(geom.ToMatrix())), nil
+}
+
+// MinimumWidth returns the minimum width, or minimum diameter, of the geometry as a line,
+// from the projection of a vertex of the convex hull on the opposite edge to the vertex, its length is the width.
+func (g *MegrezAlgorithm) MinimumWidth(geom space.Geometry) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	width := buffer.MinimumWidth(geom.ToMatrix())
+	if width == nil {
+		return space.LineString{}, nil
+	}
+	return space.LineString(width), nil
+}
+
+// OffsetCurve returns the line displaced by the distance to the left, or to the right for a negative distance,
+// with the join style and mitre limit of the params, the default params if nil.
+// Loops at tight bends are removed, so the result may be a MultiLineString,
+// the lines of a MultiLineString or a collection are offset one by one.
+func (g *MegrezAlgorithm) OffsetCurve(geom space.Geometry, distance float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	if geom == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	curve := buffer.OffsetCurve(geom.ToMatrix(), distance, params)
+	if curve == nil {
+		return space.LineString{}, nil
+	}
+	return space.TransGeometry(curve), nil
+}
+
 // PointOnSurface Returns a POINT guaranteed to intersect a surface.
 func (g *MegrezAlgorithm) PointOnSurface(geom space.Geometry) (space.Geometry, error) {
 	m := buffer.InteriorPoint(geom.ToMatrix())
@@ -97,3 +255,44 @@ func (g *MegrezAlgorithm) Snap(input, reference space.Geometry, tolerance float6
 func (g *MegrezAlgorithm) UniquePoints(geom space.Geometry) (space.Geometry, error) {
 	return geom.UniquePoints(), nil
 }
+
+// VoronoiDiagram returns the Voronoi diagram of the vertices of the sites, clipped to the clip bound,
+// vertices closer than the tolerance are merged into one site.
+// If the clip bound is empty, the bound of the sites expanded by its largest extent is used.
+// The result is a GeometryCollection with a polygon per vertex of the sites, the cell of the site of the i-th vertex is its i-th polygon,
+// an empty polygon if the cell is outside the clip bound.
+// If onlyEdges is true the result is a MultiLineString of the sides shared by two cells.
+func (g *MegrezAlgorithm) VoronoiDiagram(sites space.Geometry, clip space.Bound, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	if sites == nil {
+		return nil, spaceerr.ErrNilGeometry
+	}
+	var env *envelope.Envelope
+	if !clip.IsEmpty() {
+		env = envelope.FourFloat(clip.Min[0], clip.Max[0], clip.Min[1], clip.Max[1])
+	}
+	if onlyEdges {
+		result := space.MultiLineString{}
+		for _, v := range triangulate.VoronoiEdges(sites.ToMatrix(), env, tolerance) {
+			result = append(result, space.LineString(v))
+		}
+		return result, nil
+	}
+	cells := triangulate.VoronoiDiagram(sites.ToMatrix(), env, tolerance)
+	n := 0
+	for _, v := range cells {
+		n += len(v.Indexes)
+	}
+	result := make(space.Collection, n)
+	for i := range result {
+		result[i] = space.Polygon{}
+	}
+	for _, v := range cells {
+		if len(v.Polygon) == 0 {
+			continue
+		}
+		for _, i := range v.Indexes {
+			result[i] = space.Polygon(v.Polygon)
+		}
+	}
+	return result, nil
+}
@@ -1,9 +1,12 @@
 package planar
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/calc"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
 )
@@ -60,6 +63,10 @@ func TestAlgorithm_Boundary(t *testing.T) {
 func TestAlgorithm_Buffer(t *testing.T) {
 	geometry, _ := wkt.UnmarshalString("POINT(100 90)")
 	expectGeometry, _ := wkt.UnmarshalString("POLYGON((150 90,146.193976625564 70.8658283817455,135.355339059327 54.6446609406727,119.134171618255 43.8060233744357,100 40,80.8658283817456 43.8060233744356,64.6446609406727 54.6446609406725,53.8060233744357 70.8658283817454,50 89.9999999999998,53.8060233744356 109.134171618254,64.6446609406725 125.355339059327,80.8658283817453 136.193976625564,99.9999999999998 140,119.134171618254 136.193976625564,135.355339059327 125.355339059328,146.193976625564 109.134171618255,150 90))")
+	multiPolygon, _ := wkt.UnmarshalString("MULTIPOLYGON(((0 0,10 0,10 10,0 10,0 0)),((5 5,15 5,15 15,5 15,5 5)))")
+	multiPolygonBuffer, _ := wkt.UnmarshalString("POLYGON((-1 0,0 -1,10 -1,11 0,11 4,15 4,16 5,16 15,15 16,5 16,4 15,4 11,0 11,-1 10,-1 0))")
+	dumbbell, _ := wkt.UnmarshalString("POLYGON((0 0,4 0,4 1.5,6 1.5,6 0,10 0,10 4,6 4,6 2.5,4 2.5,4 4,0 4,0 0))")
+	dumbbellBuffer, _ := wkt.UnmarshalString("MULTIPOLYGON(((1 1,3 1,3 1.5,3.5 2,3 2.5,3 3,1 3,1 1)),((6.5 2,7 1.5,7 1,9 1,9 3,7 3,7 2.5,6.5 2)))")
 	type args struct {
 		g        space.Geometry
 		width    float64
@@ -71,6 +78,9 @@ func TestAlgorithm_Buffer(t *testing.T) {
 		want space.Geometry
 	}{
 		{name: "buffer", args: args{g: geometry, width: 50, quadsegs: 4}, want: expectGeometry},
+		{name: "buffer multi polygon", args: args{g: multiPolygon, width: 1, quadsegs: 1}, want: multiPolygonBuffer},
+		{name: "buffer negative split", args: args{g: dumbbell, width: -1, quadsegs: 1}, want: dumbbellBuffer},
+		{name: "buffer negative eroded", args: args{g: dumbbell, width: -3, quadsegs: 1}, want: space.Polygon{}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -84,6 +94,47 @@ func TestAlgorithm_Buffer(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_BufferWithParams(t *testing.T) {
+	line, _ := wkt.UnmarshalString("LINESTRING(0 0,10 0,10 10)")
+	flatMitre, _ := wkt.UnmarshalString("POLYGON((9 1,9 10,11 10,11 -1,0 -1,0 1,9 1))")
+	singleSided, _ := wkt.UnmarshalString("POLYGON((10 10,10 0,0 0,0 1,9 1,9 10,10 10))")
+	type args struct {
+		g      space.Geometry
+		width  float64
+		params *buffer.CurveParameters
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "flat mitre", args: args{g: line, width: 1,
+			params: &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CAPFLAT, JoinStyle: calc.JOINMITRE, MitreLimit: 5}},
+			want: flatMitre},
+		{name: "single sided", args: args{g: line, width: 1,
+			params: &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CAPFLAT, JoinStyle: calc.JOINMITRE, MitreLimit: 5, IsSingleSided: true}},
+			want: singleSided},
+		{name: "nil geometry", args: args{g: nil, width: 1}, want: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			gotGeometry, err := G.BufferWithParams(tt.args.g, tt.args.width, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BufferWithParams() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if isEqual, _ := G.EqualsExact(gotGeometry, tt.want, 0.000001); !isEqual {
+				t.Errorf("BufferWithParams() = %v\n, want %v", wkt.MarshalString(gotGeometry), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
 func TestAlgorithm_Centroid(t *testing.T) {
 	const multipoint = `MULTIPOINT ( -1 0, -1 2, -1 3, -1 4, -1 7, 0 1, 0 3, 1 1, 2 0, 6 0, 7 8, 9 8, 10 6 )`
 	geometry, _ := wkt.UnmarshalString(multipoint)
@@ -193,6 +244,47 @@ func TestAlgorithm_Envelope(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_OffsetCurve(t *testing.T) {
+	line, _ := wkt.UnmarshalString("LINESTRING(0 0,10 0,10 10)")
+	multiLine, _ := wkt.UnmarshalString("MULTILINESTRING((0 0,10 0,10 10),(0 5,5 5))")
+	left, _ := wkt.UnmarshalString("LINESTRING(0 1,9 1,9 10)")
+	right, _ := wkt.UnmarshalString("LINESTRING(0 -1,11 -1,11 10)")
+	multiLeft, _ := wkt.UnmarshalString("MULTILINESTRING((0 1,9 1,9 10),(0 6,5 6))")
+	mitre := &buffer.CurveParameters{QuadrantSegments: 8, EndCapStyle: calc.CAPROUND, JoinStyle: calc.JOINMITRE, MitreLimit: 5, SimplifyFactor: 0.01}
+	type args struct {
+		g        space.Geometry
+		distance float64
+		params   *buffer.CurveParameters
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "left", args: args{g: line, distance: 1}, want: left},
+		{name: "right mitre", args: args{g: line, distance: -1, params: mitre}, want: right},
+		{name: "multi line", args: args{g: multiLine, distance: 1, params: mitre}, want: multiLeft},
+		{name: "nil geometry", args: args{g: nil, distance: 1}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.OffsetCurve(tt.args.g, tt.args.distance, tt.args.params)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OffsetCurve() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if isEqual, _ := G.EqualsExact(got, tt.want, 0.000001); !isEqual {
+				t.Errorf("OffsetCurve() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+}
+
 func TestAlgorithm_PointOnSurface(t *testing.T) {
 	point, _ := wkt.UnmarshalString(`POINT(0 5)`)
 	expectPoint0, _ := wkt.UnmarshalString(`POINT(0 5)`)
@@ -375,3 +467,225 @@ func TestAlgorithm_UniquePoints(t *testing.T) {
 		})
 	}
 }
+
+func TestAlgorithm_DelaunayTriangulation(t *testing.T) {
+	square, _ := wkt.UnmarshalString("POLYGON((0 0,10 0,10 10,0 10,0 0))")
+	points, _ := wkt.UnmarshalString("MULTIPOINT(0 0,10 0,10 10,0 10,5 5,5 5.001)")
+	concave, _ := wkt.UnmarshalString("POLYGON((0 0,10 0,10 10,5 1,0 10,0 0))")
+	type args struct {
+		g           space.Geometry
+		tolerance   float64
+		onlyEdges   bool
+		constrained bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		area    float64
+		wantErr bool
+	}{
+		{name: "square", args: args{g: square}, want: 2, area: 100},
+		{name: "square edges", args: args{g: square, onlyEdges: true}, want: 5},
+		{name: "points tolerance", args: args{g: points, tolerance: 0.01}, want: 4, area: 100},
+		{name: "constrained concave", args: args{g: concave, constrained: true}, want: 3, area: 55},
+		{name: "nil geometry", args: args{g: nil}, wantErr: true},
+		{name: "constrained nil geometry", args: args{g: nil, constrained: true}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			var got space.Geometry
+			var err error
+			if tt.args.constrained {
+				got, err = G.ConstrainedDelaunayTriangulation(tt.args.g)
+			} else {
+				got, err = G.DelaunayTriangulation(tt.args.g, tt.args.tolerance, tt.args.onlyEdges)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DelaunayTriangulation() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if n, _ := G.NGeometry(got); n != tt.want {
+				t.Errorf("DelaunayTriangulation() = %v, want %v geometries", wkt.MarshalString(got), tt.want)
+			}
+			if tt.args.onlyEdges {
+				if got.GeoJSONType() != space.TypeMultiLineString {
+					t.Errorf("DelaunayTriangulation() edges = %v, want MultiLineString", got.GeoJSONType())
+				}
+				return
+			}
+			area := 0.0
+			for _, v := range got.(space.Collection) {
+				a, _ := G.Area(v)
+				area += a
+			}
+			if area != tt.area {
+				t.Errorf("DelaunayTriangulation() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_VoronoiDiagram(t *testing.T) {
+	sites, _ := wkt.UnmarshalString("MULTIPOINT(3 1,1 1,3 1)")
+	outside, _ := wkt.UnmarshalString("MULTIPOINT(1 1,10 1)")
+	right, _ := wkt.UnmarshalString("POLYGON((2 0,4 0,4 2,2 2,2 0))")
+	left, _ := wkt.UnmarshalString("POLYGON((0 0,2 0,2 2,0 2,0 0))")
+	edges, _ := wkt.UnmarshalString("MULTILINESTRING((2 0,2 2))")
+	clip := space.Bound{Min: space.Point{0, 0}, Max: space.Point{4, 2}}
+	type args struct {
+		sites     space.Geometry
+		clip      space.Bound
+		onlyEdges bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    space.Geometry
+		wantErr bool
+	}{
+		{name: "cells by vertex", args: args{sites: sites, clip: clip}, want: space.Collection{right, left, right}},
+		{name: "outside clip", args: args{sites: outside, clip: space.Bound{Min: space.Point{0, 0}, Max: space.Point{2, 2}}},
+			want: space.Collection{left, space.Polygon{}}},
+		{name: "edges", args: args{sites: sites, clip: clip, onlyEdges: true}, want: edges},
+		{name: "nil geometry", args: args{sites: nil}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.VoronoiDiagram(tt.args.sites, tt.args.clip, 0, tt.args.onlyEdges)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("VoronoiDiagram() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if !got.EqualsExact(tt.want, 0.000001) {
+				t.Errorf("VoronoiDiagram() = %v, want %v", wkt.MarshalString(got), wkt.MarshalString(tt.want))
+			}
+		})
+	}
+
+	cells, _ := NormalStrategy().VoronoiDiagram(sites, space.Bound{}, 0, false)
+	if n, _ := NormalStrategy().NGeometry(cells); n != 3 {
+		t.Errorf("VoronoiDiagram() = %v, want 3 cells", wkt.MarshalString(cells))
+	}
+}
+
+func TestAlgorithm_ConcaveHull(t *testing.T) {
+	points, _ := wkt.UnmarshalString("MULTIPOINT(0 0,10 0,11 8,-1 8,5 6)")
+	line, _ := wkt.UnmarshalString("LINESTRING(0 0,1 1,2 2)")
+	type args struct {
+		g            space.Geometry
+		length       float64
+		byLength     bool
+		holesAllowed bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		area    float64
+		want    string
+		wantErr bool
+	}{
+		{name: "notch", args: args{g: points, length: 11, byLength: true}, area: 76, want: space.TypePolygon},
+		{name: "ratio convex", args: args{g: points, length: 1}, area: 88, want: space.TypePolygon},
+		{name: "ratio concave", args: args{g: points, length: 0}, area: 76, want: space.TypePolygon},
+		{name: "collinear", args: args{g: line, length: 0}, want: space.TypeLineString},
+		{name: "nil geometry", args: args{g: nil}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			var got space.Geometry
+			var err error
+			if tt.args.byLength {
+				got, err = G.ConcaveHullByLength(tt.args.g, tt.args.length, tt.args.holesAllowed)
+			} else {
+				got, err = G.ConcaveHull(tt.args.g, tt.args.length, tt.args.holesAllowed)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ConcaveHull() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.GeoJSONType() != tt.want {
+				t.Errorf("ConcaveHull() = %v, want %v", wkt.MarshalString(got), tt.want)
+			}
+			if area, _ := G.Area(got); area != tt.area {
+				t.Errorf("ConcaveHull() area = %v, want %v", area, tt.area)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MaximumInscribedCircle(t *testing.T) {
+	u, _ := wkt.UnmarshalString("POLYGON((0 0,10 0,10 10,8 10,8 2,4 2,4 10,0 10,0 0))")
+	multi, _ := wkt.UnmarshalString("MULTIPOLYGON(((20 0,22 0,22 2,20 2,20 0)),((0 0,10 0,10 10,0 10,0 0)))")
+	G := NormalStrategy()
+	tests := []struct {
+		name   string
+		geom   space.Geometry
+		center space.Point
+		radius float64
+	}{
+		{name: "u shape", geom: u, center: space.Point{2, 6}, radius: 2},
+		{name: "multi polygon", geom: multi, center: space.Point{5, 5}, radius: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			center, radius, err := G.MaximumInscribedCircle(tt.geom, 0.001)
+			if err != nil || math.Abs(radius-tt.radius) > 0.001 || math.Abs(center[0]-tt.center[0]) > 0.001 {
+				t.Errorf("MaximumInscribedCircle() = %v %v %v, want %v %v", center, radius, err, tt.center, tt.radius)
+			}
+		})
+	}
+	if _, _, err := G.MaximumInscribedCircle(space.LineString{{0, 0}, {1, 1}}, 0); err == nil {
+		t.Errorf("MaximumInscribedCircle() line error = nil")
+	}
+	if _, _, err := G.MaximumInscribedCircle(nil, 0); err == nil {
+		t.Errorf("MaximumInscribedCircle() nil geometry error = nil")
+	}
+}
+
+func TestAlgorithm_MinimumBounding(t *testing.T) {
+	square, _ := wkt.UnmarshalString("POLYGON((0 0,2 0,2 2,0 2,0 0))")
+	diamond, _ := wkt.UnmarshalString("MULTIPOINT(0 0,2 2,0 4,-2 2)")
+	triangle, _ := wkt.UnmarshalString("LINESTRING(0 0,10 0,5 1)")
+	G := NormalStrategy()
+
+	center, radius, err := G.MinimumBoundingRadius(square)
+	if err != nil || !center.Equals(space.Point{1, 1}) || math.Abs(radius-math.Sqrt2) > 1e-9 {
+		t.Errorf("MinimumBoundingRadius() = %v %v %v", center, radius, err)
+	}
+	circle, _ := G.MinimumBoundingCircle(square)
+	if area, _ := G.Area(circle); circle.GeoJSONType() != space.TypePolygon || area < 6 || area > 2*math.Pi {
+		t.Errorf("MinimumBoundingCircle() = %v", wkt.MarshalString(circle))
+	}
+	if point, _ := G.MinimumBoundingCircle(space.Point{3, 4}); !point.Equals(space.Point{3, 4}) {
+		t.Errorf("MinimumBoundingCircle() point = %v", wkt.MarshalString(point))
+	}
+
+	rect, _ := G.MinimumRotatedRectangle(diamond)
+	if area, _ := G.Area(rect); math.Abs(area-8) > 1e-9 {
+		t.Errorf("MinimumRotatedRectangle() = %v, want area 8", wkt.MarshalString(rect))
+	}
+	width, _ := G.MinimumWidth(triangle)
+	if !width.EqualsExact(space.LineString{{5, 0}, {5, 1}}, 0.000001) {
+		t.Errorf("MinimumWidth() = %v", wkt.MarshalString(width))
+	}
+
+	if _, err := G.MinimumWidth(nil); err == nil {
+		t.Errorf("MinimumWidth() nil geometry error = nil")
+	}
+	if _, _, err := G.MinimumBoundingRadius(nil); err == nil {
+		t.Errorf("MinimumBoundingRadius() nil geometry error = nil")
+	}
+}
@@ -3,6 +3,7 @@ package planar
 import (
 	"testing"
 
+	"github.com/spatial-go/geoos/algorithm/operation"
 	"github.com/spatial-go/geoos/encoding/wkt"
 	"github.com/spatial-go/geoos/space"
 )
@@ -141,6 +142,90 @@ func TestAlgorithm_IsSimple(t *testing.T) {
 	}
 }
 
+func TestAlgorithm_IsValidReason(t *testing.T) {
+	const bowtie = `POLYGON((0 0, 2 2, 2 0, 0 2, 0 0))`
+	const overlap = `MULTIPOLYGON(((0 0, 10 0, 10 10, 0 10, 0 0)),((5 5, 15 5, 15 15, 5 15, 5 5)))`
+	const square = `POLYGON((0 0, 10 0, 10 10, 0 10, 0 0))`
+	poly, _ := wkt.UnmarshalString(bowtie)
+	multi, _ := wkt.UnmarshalString(overlap)
+	valid, _ := wkt.UnmarshalString(square)
+
+	type args struct {
+		g space.Geometry
+	}
+	tests := []struct {
+		name     string
+		args     args
+		want     string
+		wantKind operation.ValidationKind
+	}{
+		{name: "bow tie", args: args{g: poly}, want: "Self-intersection[1 1]", wantKind: operation.SelfIntersection},
+		{name: "overlapping multipolygon", args: args{g: multi}, want: "Self-intersection[10 5]", wantKind: operation.SelfIntersection},
+		{name: "valid", args: args{g: space.WithSRID(valid, space.WGS84)}, want: "Valid Geometry"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.IsValidReason(tt.args.g)
+			if err != nil || got != tt.want {
+				t.Errorf("IsValidReason() got = %v, %v, want %v", got, err, tt.want)
+			}
+			detail, err := G.IsValidDetail(tt.args.g)
+			if err != nil || (detail == nil) != (tt.want == operation.ValidGeometry) ||
+				(detail != nil && detail.Kind != tt.wantKind) {
+				t.Errorf("IsValidDetail() got = %v, %v, want %v", detail, err, tt.wantKind)
+			}
+		})
+	}
+}
+
+func TestAlgorithm_MakeValid(t *testing.T) {
+	const bowtie = `POLYGON((0 0, 2 2, 2 0, 0 2, 0 0))`
+	const collapsed = `POLYGON((0 0, 1 1, 0 0))`
+	const overlap = `MULTIPOLYGON(((0 0, 10 0, 10 10, 0 10, 0 0)),((5 5, 15 5, 15 15, 5 15, 5 5)))`
+	poly, _ := wkt.UnmarshalString(bowtie)
+	line, _ := wkt.UnmarshalString(collapsed)
+	multi, _ := wkt.UnmarshalString(overlap)
+	// the hole touching the shell at a point stays a hole of the repaired polygon.
+	touching, _ := wkt.UnmarshalString(`POLYGON((0 0, 10 0, 10 10, 0 10, 0 0), (0 5, 2 6, 2 4, 0 5), (12 2, 12 4, 14 4, 12 2))`)
+	inverted, _ := wkt.UnmarshalString(`POLYGON((0 0, 10 0, 10 10, 5 10, 6 8, 4 8, 5 10, 0 10, 0 0))`)
+
+	type args struct {
+		g space.Geometry
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantType string
+		wantArea float64
+	}{
+		{name: "bow tie", args: args{g: poly}, wantType: space.TypeMultiPolygon, wantArea: 2},
+		{name: "collapsed", args: args{g: line}, wantType: space.TypeLineString, wantArea: 0},
+		{name: "overlapping multipolygon", args: args{g: multi}, wantType: space.TypePolygon, wantArea: 175},
+		{name: "hole touching shell", args: args{g: touching}, wantType: space.TypeMultiPolygon, wantArea: 100},
+		{name: "inverted shell", args: args{g: inverted}, wantType: space.TypePolygon, wantArea: 98},
+		{name: "srid", args: args{g: space.WithSRID(poly, space.PseudoMercator)}, wantType: space.TypeMultiPolygon, wantArea: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			G := NormalStrategy()
+			got, err := G.MakeValid(tt.args.g)
+			if err != nil {
+				t.Fatalf("MakeValid() error = %v", err)
+			}
+			if got.GeoJSONType() != tt.wantType || space.SRIDOf(got) != space.SRIDOf(tt.args.g) {
+				t.Errorf("MakeValid() got = %v, want %v", got, tt.wantType)
+			}
+			if area, _ := G.Area(got); area != tt.wantArea {
+				t.Errorf("MakeValid() area = %v, want %v", area, tt.wantArea)
+			}
+			if reason, _ := G.IsValidReason(got); reason != operation.ValidGeometry {
+				t.Errorf("MakeValid() got = %v, %v", got, reason)
+			}
+		})
+	}
+}
+
 func TestAlgorithm_IsRing(t *testing.T) {
 	const linestring1 = `LINESTRING(1 2, 3 4, 5 6, 5 3, 1 2)`
 	const linestring2 = `LINESTRING(1 1,2 2,2 3.5,1 3,1 2,2 1)`
@@ -0,0 +1,520 @@
+package planar
+
+import (
+	"github.com/spatial-go/geoos/algorithm/buffer"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/algorithm/operation"
+	"github.com/spatial-go/geoos/space"
+)
+
+// sridAlgorithm decorates an Algorithm so that it understands space.SRIDGeometry.
+// The SRIDs of the arguments are checked, the algorithm works on the untagged geometries
+// and geometry results are tagged with the SRID again.
+// Distance, DWithin, NearestPoints, ShortestLine, Length and Area are geodesic for a geographic SRID.
+type sridAlgorithm struct {
+	Algorithm
+}
+
+// unwrapSRID returns the untagged geometries and their common SRID.
+func unwrapSRID(geom1, geom2 space.Geometry) (space.Geometry, space.Geometry, int, error) {
+	srid, err := space.CheckSRID(geom1, geom2)
+	return space.Unwrap(geom1), space.Unwrap(geom2), srid, err
+}
+
+// Area returns the area of a polygonal geometry.
+func (s *sridAlgorithm) Area(geom space.Geometry) (float64, error) {
+	if space.IsGeographic(space.SRIDOf(geom)) {
+		return geom.Area()
+	}
+	return s.Algorithm.Area(space.Unwrap(geom))
+}
+
+// Boundary returns the closure of the combinatorial boundary of this space.Geometry.
+func (s *sridAlgorithm) Boundary(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.Boundary(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Buffer Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance.
+func (s *sridAlgorithm) Buffer(geom space.Geometry, width float64, quadsegs int) space.Geometry {
+	return space.WithSRID(s.Algorithm.Buffer(space.Unwrap(geom), width, quadsegs), space.SRIDOf(geom))
+}
+
+// BufferWithParams Returns a geometry that represents all points whose distance
+// from this space.Geometry is less than or equal to distance, with the buffer params.
+func (s *sridAlgorithm) BufferWithParams(geom space.Geometry, width float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	result, err := s.Algorithm.BufferWithParams(space.Unwrap(geom), width, params)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Centroid  computes the geometric center of a geometry.
+func (s *sridAlgorithm) Centroid(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.Centroid(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// ConcaveHull returns the concave hull of the vertices of the geometry, with the max edge length as a ratio.
+func (s *sridAlgorithm) ConcaveHull(geom space.Geometry, lengthRatio float64, holesAllowed bool) (space.Geometry, error) {
+	result, err := s.Algorithm.ConcaveHull(space.Unwrap(geom), lengthRatio, holesAllowed)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// ConcaveHullByLength returns the concave hull of the vertices of the geometry, with the max edge length.
+func (s *sridAlgorithm) ConcaveHullByLength(geom space.Geometry, maxEdgeLength float64, holesAllowed bool) (space.Geometry, error) {
+	result, err := s.Algorithm.ConcaveHullByLength(space.Unwrap(geom), maxEdgeLength, holesAllowed)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// ConstrainedDelaunayTriangulation returns the constrained Delaunay triangulation of the geometry.
+func (s *sridAlgorithm) ConstrainedDelaunayTriangulation(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.ConstrainedDelaunayTriangulation(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Contains space.Geometry A contains space.Geometry B if and only if no points of B lie in the exterior of A.
+func (s *sridAlgorithm) Contains(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Contains(g1, g2)
+}
+
+// ConvexHull computes the convex hull of a geometry.
+func (s *sridAlgorithm) ConvexHull(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.ConvexHull(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// CoveredBy returns TRUE if no point in space.Geometry A is outside space.Geometry B
+func (s *sridAlgorithm) CoveredBy(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.CoveredBy(g1, g2)
+}
+
+// Covers returns TRUE if no point in space.Geometry B is outside space.Geometry A
+func (s *sridAlgorithm) Covers(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Covers(g1, g2)
+}
+
+// Crosses takes two geometry objects and returns TRUE if their intersection "spatially cross".
+func (s *sridAlgorithm) Crosses(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Crosses(g1, g2)
+}
+
+// DelaunayTriangulation returns the Delaunay triangulation of the vertices of the geometry.
+func (s *sridAlgorithm) DelaunayTriangulation(geom space.Geometry, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	result, err := s.Algorithm.DelaunayTriangulation(space.Unwrap(geom), tolerance, onlyEdges)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Difference returns a geometry that represents that part of geometry A that does not intersect with geometry B.
+func (s *sridAlgorithm) Difference(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Algorithm.Difference(g1, g2)
+	return space.WithSRID(result, srid), err
+}
+
+// Disjoint Overlaps, Touches, Within all imply geometries are not spatially disjoint.
+func (s *sridAlgorithm) Disjoint(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Disjoint(g1, g2)
+}
+
+// Distance returns the minimum distance between two geometries, in m for a geographic SRID.
+func (s *sridAlgorithm) Distance(geom1, geom2 space.Geometry) (float64, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return 0, err
+	}
+	if space.IsGeographic(srid) {
+		return space.WithSRID(g1, srid).Distance(g2)
+	}
+	return s.Algorithm.Distance(g1, g2)
+}
+
+// SphericalDistance calculates spherical distance
+func (s *sridAlgorithm) SphericalDistance(geom1, geom2 space.Geometry) (float64, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return 0, err
+	}
+	return s.Algorithm.SphericalDistance(g1, g2)
+}
+
+// NearestPoints returns the points of the two geometries which are nearest to each other,
+// nearest by the geodesic distance for a geographic SRID.
+func (s *sridAlgorithm) NearestPoints(geom1, geom2 space.Geometry) (space.Point, space.Point, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return nil, nil, err
+	}
+	if space.IsGeographic(srid) {
+		return space.NearestPoints(g1, g2, measure.SpheroidDistance)
+	}
+	return s.Algorithm.NearestPoints(g1, g2)
+}
+
+// ShortestLine returns the line joining the nearest points of two geometries,
+// nearest by the geodesic distance for a geographic SRID.
+func (s *sridAlgorithm) ShortestLine(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	if space.IsGeographic(srid) {
+		result, err := shortestLine(space.NearestPoints(g1, g2, measure.SpheroidDistance))
+		return space.WithSRID(result, srid), err
+	}
+	result, err := s.Algorithm.ShortestLine(g1, g2)
+	return space.WithSRID(result, srid), err
+}
+
+// DWithin returns true if the geometries are within the distance of each other, in m for a geographic SRID.
+func (s *sridAlgorithm) DWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	if space.IsGeographic(srid) {
+		return s.Algorithm.SphericalDWithin(g1, g2, distance)
+	}
+	return s.Algorithm.DWithin(g1, g2, distance)
+}
+
+// SphericalDWithin returns true if the lon/lat geometries are within the distance in m of each other.
+func (s *sridAlgorithm) SphericalDWithin(geom1, geom2 space.Geometry, distance float64) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.SphericalDWithin(g1, g2, distance)
+}
+
+// Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
+func (s *sridAlgorithm) Envelope(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.Envelope(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Equals returns TRUE if the given Geometries are "spatially equal".
+func (s *sridAlgorithm) Equals(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Equals(g1, g2)
+}
+
+// EqualsExact returns true if both geometries are Equal, as evaluated by their
+// points being within the given tolerance.
+func (s *sridAlgorithm) EqualsExact(geom1, geom2 space.Geometry, tolerance float64) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.EqualsExact(g1, g2, tolerance)
+}
+
+// FrechetDistance returns the discrete Frechet distance between two geometries.
+func (s *sridAlgorithm) FrechetDistance(geom1, geom2 space.Geometry) (float64, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return 0, err
+	}
+	return s.Algorithm.FrechetDistance(g1, g2)
+}
+
+// FrechetDistanceDensify computes the Frechet distance with an additional densification fraction amount
+func (s *sridAlgorithm) FrechetDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return 0, err
+	}
+	return s.Algorithm.FrechetDistanceDensify(g1, g2, densifyFrac)
+}
+
+// HausdorffDistance returns the Hausdorff distance between two geometries.
+func (s *sridAlgorithm) HausdorffDistance(geom1, geom2 space.Geometry) (float64, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return 0, err
+	}
+	return s.Algorithm.HausdorffDistance(g1, g2)
+}
+
+// HausdorffDistanceDensify computes the Hausdorff distance with an additional densification fraction amount
+func (s *sridAlgorithm) HausdorffDistanceDensify(geom1, geom2 space.Geometry, densifyFrac float64) (float64, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return 0, err
+	}
+	return s.Algorithm.HausdorffDistanceDensify(g1, g2, densifyFrac)
+}
+
+// Intersection returns a geometry that represents the point set intersection of the Geometries.
+func (s *sridAlgorithm) Intersection(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Algorithm.Intersection(g1, g2)
+	return space.WithSRID(result, srid), err
+}
+
+// Intersects If a geometry  shares any portion of space then they intersect
+func (s *sridAlgorithm) Intersects(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Intersects(g1, g2)
+}
+
+// IsClosed Returns TRUE if the LINESTRING's start and end points are coincident.
+func (s *sridAlgorithm) IsClosed(geom space.Geometry) (bool, error) {
+	return s.Algorithm.IsClosed(space.Unwrap(geom))
+}
+
+// IsEmpty returns true if this space.Geometry is an empty geometry.
+func (s *sridAlgorithm) IsEmpty(geom space.Geometry) (bool, error) {
+	return s.Algorithm.IsEmpty(space.Unwrap(geom))
+}
+
+// IsRing returns true if the lineal geometry has the ring property.
+func (s *sridAlgorithm) IsRing(geom space.Geometry) (bool, error) {
+	return s.Algorithm.IsRing(space.Unwrap(geom))
+}
+
+// IsSimple returns true if this space.Geometry has no anomalous geometric points.
+func (s *sridAlgorithm) IsSimple(geom space.Geometry) (bool, error) {
+	return s.Algorithm.IsSimple(space.Unwrap(geom))
+}
+
+// IsValidReason returns the reason the geometry is not valid with its location.
+func (s *sridAlgorithm) IsValidReason(geom space.Geometry) (string, error) {
+	return s.Algorithm.IsValidReason(space.Unwrap(geom))
+}
+
+// IsValidDetail returns why the geometry is not valid and where, nil if it is valid.
+func (s *sridAlgorithm) IsValidDetail(geom space.Geometry) (*operation.ValidationError, error) {
+	return s.Algorithm.IsValidDetail(space.Unwrap(geom))
+}
+
+// MakeValid returns a valid geometry which keeps all the area of the geometry.
+func (s *sridAlgorithm) MakeValid(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.MakeValid(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// MaximumInscribedCircle returns the center and the radius of the largest circle inside the polygon.
+func (s *sridAlgorithm) MaximumInscribedCircle(geom space.Geometry, tolerance float64) (space.Point, float64, error) {
+	return s.Algorithm.MaximumInscribedCircle(space.Unwrap(geom), tolerance)
+}
+
+// MinimumBoundingCircle returns the smallest circle polygon which contains all the vertices of the geometry.
+func (s *sridAlgorithm) MinimumBoundingCircle(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.MinimumBoundingCircle(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// MinimumBoundingRadius returns the center and the radius of the smallest circle which contains all the vertices of the geometry.
+func (s *sridAlgorithm) MinimumBoundingRadius(geom space.Geometry) (space.Point, float64, error) {
+	return s.Algorithm.MinimumBoundingRadius(space.Unwrap(geom))
+}
+
+// MinimumRotatedRectangle returns the rectangle of the smallest area which contains all the vertices of the geometry.
+func (s *sridAlgorithm) MinimumRotatedRectangle(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.MinimumRotatedRectangle(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// MinimumWidth returns the minimum width of the geometry as a line.
+func (s *sridAlgorithm) MinimumWidth(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.MinimumWidth(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Length returns the length of the geometry, in m for a geographic SRID.
+func (s *sridAlgorithm) Length(geom space.Geometry) (float64, error) {
+	if space.IsGeographic(space.SRIDOf(geom)) {
+		return geom.Length(), nil
+	}
+	return s.Algorithm.Length(space.Unwrap(geom))
+}
+
+// LineMerge returns a (set of) LineString(s) formed by sewing together the constituent line work of a MULTILINESTRING.
+func (s *sridAlgorithm) LineMerge(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.LineMerge(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// NGeometry returns the number of component geometries.
+func (s *sridAlgorithm) NGeometry(geom space.Geometry) (int, error) {
+	return s.Algorithm.NGeometry(space.Unwrap(geom))
+}
+
+// Node returns a MultiLineString of the linework of the geometry fully noded.
+func (s *sridAlgorithm) Node(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.Node(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// OffsetCurve returns the line displaced by the distance to the left, or to the right for a negative distance.
+func (s *sridAlgorithm) OffsetCurve(geom space.Geometry, distance float64, params *buffer.CurveParameters) (space.Geometry, error) {
+	result, err := s.Algorithm.OffsetCurve(space.Unwrap(geom), distance, params)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Overlaps returns TRUE if the Geometries "spatially overlap".
+func (s *sridAlgorithm) Overlaps(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Overlaps(g1, g2)
+}
+
+// PointOnSurface Returns a POINT guaranteed to intersect a surface.
+func (s *sridAlgorithm) PointOnSurface(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.PointOnSurface(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Polygonize returns a GeometryCollection of the polygons formed by the linework of the geometry.
+func (s *sridAlgorithm) Polygonize(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.Polygonize(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// PolygonizeFull returns the polygons formed by the linework of the geometry, its dangles, cut edges and invalid rings.
+func (s *sridAlgorithm) PolygonizeFull(geom space.Geometry) (polygons, dangles, cutEdges, invalidRings space.Geometry, err error) {
+	polygons, dangles, cutEdges, invalidRings, err = s.Algorithm.PolygonizeFull(space.Unwrap(geom))
+	srid := space.SRIDOf(geom)
+	return space.WithSRID(polygons, srid), space.WithSRID(dangles, srid), space.WithSRID(cutEdges, srid),
+		space.WithSRID(invalidRings, srid), err
+}
+
+// Relate Computes the  Intersection Matrix for the spatial relationship between two geometries.
+func (s *sridAlgorithm) Relate(geom1, geom2 space.Geometry) (string, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return "", err
+	}
+	return s.Algorithm.Relate(g1, g2)
+}
+
+// SharedPaths returns a collection containing paths shared by the two input geometries.
+func (s *sridAlgorithm) SharedPaths(geom1, geom2 space.Geometry) (string, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return "", err
+	}
+	return s.Algorithm.SharedPaths(g1, g2)
+}
+
+// Simplify returns a "simplified" version of the given geometry using the Douglas-Peucker algorithm.
+func (s *sridAlgorithm) Simplify(geom space.Geometry, tolerance float64) (space.Geometry, error) {
+	result, err := s.Algorithm.Simplify(space.Unwrap(geom), tolerance)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// SimplifyP returns a geometry simplified by amount given by tolerance.
+func (s *sridAlgorithm) SimplifyP(geom space.Geometry, tolerance float64) (space.Geometry, error) {
+	result, err := s.Algorithm.SimplifyP(space.Unwrap(geom), tolerance)
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Snap the vertices and segments of a geometry to another Geometry's vertices.
+func (s *sridAlgorithm) Snap(input, reference space.Geometry, tolerance float64) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(input, reference)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Algorithm.Snap(g1, g2, tolerance)
+	return space.WithSRID(result, srid), err
+}
+
+// Split returns a GeometryCollection of the pieces of the input cut by the blade.
+func (s *sridAlgorithm) Split(input, blade space.Geometry) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(input, blade)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Algorithm.Split(g1, g2)
+	return space.WithSRID(result, srid), err
+}
+
+// SymDifference returns a geometry that represents the portions of A and B that do not intersect.
+func (s *sridAlgorithm) SymDifference(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Algorithm.SymDifference(g1, g2)
+	return space.WithSRID(result, srid), err
+}
+
+// Touches returns TRUE if the only points in common between geom1 and geom2 lie in the union of the boundaries.
+func (s *sridAlgorithm) Touches(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Touches(g1, g2)
+}
+
+// UnaryUnion does dissolve boundaries between components of a multipolygon.
+func (s *sridAlgorithm) UnaryUnion(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.UnaryUnion(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// Union returns a new geometry representing all points in this geometry and the other.
+func (s *sridAlgorithm) Union(geom1, geom2 space.Geometry) (space.Geometry, error) {
+	g1, g2, srid, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.Algorithm.Union(g1, g2)
+	return space.WithSRID(result, srid), err
+}
+
+// UniquePoints return all distinct vertices of input geometry as a MultiPoint.
+func (s *sridAlgorithm) UniquePoints(geom space.Geometry) (space.Geometry, error) {
+	result, err := s.Algorithm.UniquePoints(space.Unwrap(geom))
+	return space.WithSRID(result, space.SRIDOf(geom)), err
+}
+
+// VoronoiDiagram returns the Voronoi diagram of the vertices of the sites, clipped to the clip bound.
+func (s *sridAlgorithm) VoronoiDiagram(sites space.Geometry, clip space.Bound, tolerance float64, onlyEdges bool) (space.Geometry, error) {
+	result, err := s.Algorithm.VoronoiDiagram(space.Unwrap(sites), clip, tolerance, onlyEdges)
+	return space.WithSRID(result, space.SRIDOf(sites)), err
+}
+
+// Within returns TRUE if geometry A is completely inside geometry B.
+func (s *sridAlgorithm) Within(geom1, geom2 space.Geometry) (bool, error) {
+	g1, g2, _, err := unwrapSRID(geom1, geom2)
+	if err != nil {
+		return false, err
+	}
+	return s.Algorithm.Within(g1, g2)
+}
@@ -0,0 +1,39 @@
+package planar
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestAlgorithm_SRID(t *testing.T) {
+	G := NormalStrategy()
+	square := space.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+	wgs84, mercator := space.WithSRID(square, space.WGS84), space.WithSRID(square, space.PseudoMercator)
+
+	if area, _ := G.Area(mercator); area != 1 {
+		t.Errorf("Area() planar got = %v, want 1", area)
+	}
+	if area, _ := G.Area(wgs84); math.Abs(area-1.2363e10) > 1e7 {
+		t.Errorf("Area() geodesic got = %v, want 1.2363e10", area)
+	}
+
+	var mismatch *spaceerr.SRIDMismatchError
+	if _, err := G.Intersects(wgs84, mercator); !errors.As(err, &mismatch) {
+		t.Errorf("Intersects() error = %v, want SRIDMismatchError", err)
+	}
+	if _, err := G.Distance(wgs84, mercator); !errors.As(err, &mismatch) {
+		t.Errorf("Distance() error = %v, want SRIDMismatchError", err)
+	}
+
+	if within, err := G.Within(space.WithSRID(space.Point{0.5, 0.5}, space.WGS84), wgs84); err != nil || !within {
+		t.Errorf("Within() got = %v, %v, want true", within, err)
+	}
+	envelope, err := G.Envelope(wgs84)
+	if err != nil || space.SRIDOf(envelope) != space.WGS84 {
+		t.Errorf("Envelope() got = %v, %v, want SRID %v", envelope, err, space.WGS84)
+	}
+}
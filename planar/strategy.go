@@ -18,8 +18,9 @@ func NormalStrategy() Algorithm {
 }
 
 // GetStrategy returns  algorithm by newAlorithm.
+// The returned algorithm understands geometries tagged with an SRID, see space.SRIDGeometry.
 func GetStrategy(f newAlgorithm) Algorithm {
-	return f()
+	return &sridAlgorithm{f()}
 }
 
 func newMegrezAlgorithm() Algorithm {
@@ -0,0 +1,122 @@
+package projection
+
+import "math"
+
+// const GCJ02 and BD09 parameters.
+const (
+	// krasovskyA semi-major axis of the Krasovsky 1940 ellipsoid used by GCJ02.
+	krasovskyA = 6378245.0
+	// krasovskyEE square of the eccentricity of the Krasovsky 1940 ellipsoid.
+	krasovskyEE = 0.00669342162296594323
+
+	// bdXPi constant used by the BD09 offset.
+	bdXPi = math.Pi * 3000.0 / 180.0
+	// bdLonOffset, bdLatOffset fixed offsets of BD09 from GCJ02.
+	bdLonOffset = 0.0065
+	bdLatOffset = 0.006
+
+	// inverseTolerance iteration stop criterion of inverse transforms, unit degree.
+	inverseTolerance = 1e-12
+	// inverseMaxIterations max iterations of inverse transforms.
+	inverseMaxIterations = 30
+)
+
+// OutOfChina returns true if the lon/lat lies outside the area where GCJ02 is applied.
+// GCJ02 and BD09 leave coordinates outside this area unshifted.
+func OutOfChina(lon, lat float64) bool {
+	return lon < 72.004 || lon > 137.8347 || lat < 0.8293 || lat > 55.8271
+}
+
+// WGS84ToGCJ02 transforms lon/lat from WGS84 to GCJ02.
+func WGS84ToGCJ02(lon, lat float64) (float64, float64) {
+	if OutOfChina(lon, lat) {
+		return lon, lat
+	}
+	dLon, dLat := gcj02Delta(lon, lat)
+	return lon + dLon, lat + dLat
+}
+
+// GCJ02ToWGS84 transforms lon/lat from GCJ02 to WGS84.
+// The GCJ02 offset has no closed form inverse, it is inverted by fixed-point iteration
+// so that a round trip is accurate to better than 1e-9 degree.
+func GCJ02ToWGS84(lon, lat float64) (float64, float64) {
+	if OutOfChina(lon, lat) {
+		return lon, lat
+	}
+	return inverse(WGS84ToGCJ02, lon, lat)
+}
+
+// GCJ02ToBD09 transforms lon/lat from GCJ02 to BD09.
+func GCJ02ToBD09(lon, lat float64) (float64, float64) {
+	z := math.Sqrt(lon*lon+lat*lat) + 0.00002*math.Sin(lat*bdXPi)
+	theta := math.Atan2(lat, lon) + 0.000003*math.Cos(lon*bdXPi)
+	return z*math.Cos(theta) + bdLonOffset, z*math.Sin(theta) + bdLatOffset
+}
+
+// BD09ToGCJ02 transforms lon/lat from BD09 to GCJ02.
+// The approximate inverse of the BD09 offset is refined by fixed-point iteration
+// so that a round trip is accurate to better than 1e-9 degree.
+func BD09ToGCJ02(lon, lat float64) (float64, float64) {
+	x, y := lon-bdLonOffset, lat-bdLatOffset
+	z := math.Sqrt(x*x+y*y) - 0.00002*math.Sin(y*bdXPi)
+	theta := math.Atan2(y, x) - 0.000003*math.Cos(x*bdXPi)
+	return refine(GCJ02ToBD09, lon, lat, z*math.Cos(theta), z*math.Sin(theta))
+}
+
+// WGS84ToBD09 transforms lon/lat from WGS84 to BD09.
+func WGS84ToBD09(lon, lat float64) (float64, float64) {
+	return GCJ02ToBD09(WGS84ToGCJ02(lon, lat))
+}
+
+// BD09ToWGS84 transforms lon/lat from BD09 to WGS84.
+func BD09ToWGS84(lon, lat float64) (float64, float64) {
+	return GCJ02ToWGS84(BD09ToGCJ02(lon, lat))
+}
+
+// gcj02Delta returns the GCJ02 shift of a WGS84 lon/lat.
+func gcj02Delta(lon, lat float64) (float64, float64) {
+	dLat := transformLat(lon-105.0, lat-35.0)
+	dLon := transformLon(lon-105.0, lat-35.0)
+	radLat := lat / 180.0 * math.Pi
+	magic := math.Sin(radLat)
+	magic = 1 - krasovskyEE*magic*magic
+	sqrtMagic := math.Sqrt(magic)
+	dLat = (dLat * 180.0) / ((krasovskyA * (1 - krasovskyEE)) / (magic * sqrtMagic) * math.Pi)
+	dLon = (dLon * 180.0) / (krasovskyA / sqrtMagic * math.Cos(radLat) * math.Pi)
+	return dLon, dLat
+}
+
+func transformLat(x, y float64) float64 {
+	ret := -100.0 + 2.0*x + 3.0*y + 0.2*y*y + 0.1*x*y + 0.2*math.Sqrt(math.Abs(x))
+	ret += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	ret += (20.0*math.Sin(y*math.Pi) + 40.0*math.Sin(y/3.0*math.Pi)) * 2.0 / 3.0
+	ret += (160.0*math.Sin(y/12.0*math.Pi) + 320*math.Sin(y*math.Pi/30.0)) * 2.0 / 3.0
+	return ret
+}
+
+func transformLon(x, y float64) float64 {
+	ret := 300.0 + x + 2.0*y + 0.1*x*x + 0.1*x*y + 0.1*math.Sqrt(math.Abs(x))
+	ret += (20.0*math.Sin(6.0*x*math.Pi) + 20.0*math.Sin(2.0*x*math.Pi)) * 2.0 / 3.0
+	ret += (20.0*math.Sin(x*math.Pi) + 40.0*math.Sin(x/3.0*math.Pi)) * 2.0 / 3.0
+	ret += (150.0*math.Sin(x/12.0*math.Pi) + 300.0*math.Sin(x/30.0*math.Pi)) * 2.0 / 3.0
+	return ret
+}
+
+// inverse inverts the forward transform at lon/lat, starting from lon/lat itself.
+func inverse(forward Func, lon, lat float64) (float64, float64) {
+	return refine(forward, lon, lat, lon, lat)
+}
+
+// refine improves the guess x/y of the inverse of forward at lon/lat by fixed-point iteration.
+// The offsets are small and smooth, so each step moves the guess by the remaining error.
+func refine(forward Func, lon, lat, x, y float64) (float64, float64) {
+	for i := 0; i < inverseMaxIterations; i++ {
+		fx, fy := forward(x, y)
+		dx, dy := fx-lon, fy-lat
+		x, y = x-dx, y-dy
+		if math.Abs(dx) < inverseTolerance && math.Abs(dy) < inverseTolerance {
+			break
+		}
+	}
+	return x, y
+}
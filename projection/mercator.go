@@ -0,0 +1,27 @@
+package projection
+
+import "math"
+
+// const Web Mercator parameters.
+const (
+	// MercatorR radius of the sphere used by Web Mercator, unit m.
+	MercatorR = 6378137.0
+	// MaxMercatorLat the latitude at which Web Mercator becomes square, unit degree.
+	MaxMercatorLat = 85.0511287798066
+)
+
+// LonLatToMercator transforms lon/lat in degree to Web Mercator x/y in m.
+// Latitudes beyond MaxMercatorLat are clamped.
+func LonLatToMercator(lon, lat float64) (float64, float64) {
+	lat = math.Max(math.Min(lat, MaxMercatorLat), -MaxMercatorLat)
+	x := MercatorR * lon * math.Pi / 180.0
+	y := MercatorR * math.Log(math.Tan(math.Pi/4.0+lat*math.Pi/360.0))
+	return x, y
+}
+
+// MercatorToLonLat transforms Web Mercator x/y in m to lon/lat in degree.
+func MercatorToLonLat(x, y float64) (float64, float64) {
+	lon := x / MercatorR * 180.0 / math.Pi
+	lat := (2.0*math.Atan(math.Exp(y/MercatorR)) - math.Pi/2.0) * 180.0 / math.Pi
+	return lon, lat
+}
@@ -0,0 +1,177 @@
+// Package projection provides transforms of geometries between the coordinate systems declared in space,
+// WGS84, CGCS2000, GCJ02, BD09 and their Web Mercator projections.
+//
+// GCJ02 and BD09 are obfuscated datums applied to data published in China.
+// Their forward transforms are closed form, the inverse transforms are solved by iteration,
+// so a forward-inverse round trip is accurate to better than 1e-9 degree (about 0.1 mm).
+// Web Mercator transforms are exact up to floating point rounding.
+// CGCS2000 is treated as coincident with WGS84, they differ by a few centimetres.
+package projection
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/space"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// Func transforms a single x/y coordinate.
+type Func func(x, y float64) (float64, float64)
+
+// coordinateSystem describes a coordinate system by its geographic datum and
+// whether coordinates are projected to Web Mercator.
+type coordinateSystem struct {
+	datum      int
+	isMercator bool
+}
+
+var coordinateSystems = map[int]coordinateSystem{
+	space.WGS84:          {space.WGS84, false},
+	space.CGCS2000:       {space.WGS84, false},
+	space.PseudoMercator: {space.WGS84, true},
+	space.GCJ02:          {space.GCJ02, false},
+	space.GCJ02Web:       {space.GCJ02, true},
+	space.BD09:           {space.BD09, false},
+	space.BD09Web:        {space.BD09, true},
+}
+
+var datumTransforms = map[[2]int]Func{
+	{space.WGS84, space.GCJ02}: WGS84ToGCJ02,
+	{space.GCJ02, space.WGS84}: GCJ02ToWGS84,
+	{space.WGS84, space.BD09}:  WGS84ToBD09,
+	{space.BD09, space.WGS84}:  BD09ToWGS84,
+	{space.GCJ02, space.BD09}:  GCJ02ToBD09,
+	{space.BD09, space.GCJ02}:  BD09ToGCJ02,
+}
+
+// IsSupported returns true if the coordinate system can be transformed by this package.
+func IsSupported(coordSys int) bool {
+	_, ok := coordinateSystems[coordSys]
+	return ok
+}
+
+// Transformer returns the function transforming coordinates from one coordinate system to another.
+func Transformer(from, to int) (Func, error) {
+	fromSys, ok := coordinateSystems[from]
+	if !ok {
+		return nil, spaceerr.ErrorNotSupportCoordinateSystem(from)
+	}
+	toSys, ok := coordinateSystems[to]
+	if !ok {
+		return nil, spaceerr.ErrorNotSupportCoordinateSystem(to)
+	}
+
+	var steps []Func
+	if fromSys.isMercator {
+		steps = append(steps, MercatorToLonLat)
+	}
+	if fromSys.datum != toSys.datum {
+		steps = append(steps, datumTransforms[[2]int{fromSys.datum, toSys.datum}])
+	}
+	if toSys.isMercator {
+		steps = append(steps, LonLatToMercator)
+	}
+
+	return func(x, y float64) (float64, float64) {
+		for _, step := range steps {
+			x, y = step(x, y)
+		}
+		return x, y
+	}, nil
+}
+
+// Transform returns a copy of geom with every vertex, including holes and collection members,
+// transformed from one coordinate system to another.
+// A geom tagged with an SRID must be in from, the result is tagged with to.
+func Transform(geom space.Geometry, from, to int) (space.Geometry, error) {
+	if srid := space.SRIDOf(geom); srid != 0 && srid != from {
+		return nil, &spaceerr.SRIDMismatchError{SRID1: srid, SRID2: from}
+	}
+	f, err := Transformer(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if space.SRIDOf(geom) != 0 {
+		return space.WithSRID(Apply(geom, f), to), nil
+	}
+	return Apply(geom, f), nil
+}
+
+// Apply returns a copy of geom with f applied to every vertex.
+// Ordinates beyond x and y are kept unchanged.
+func Apply(geom space.Geometry, f Func) space.Geometry {
+	switch g := geom.(type) {
+	case nil:
+		return nil
+	case space.SRIDGeometry:
+		return space.WithSRID(Apply(g.Geometry, f), g.SRID)
+	case space.Point:
+		if g.IsEmpty() {
+			return g
+		}
+		return space.Point(applyMatrix(matrix.Matrix(g), f))
+	case space.MultiPoint:
+		mp := make(space.MultiPoint, len(g))
+		for i, v := range g {
+			mp[i] = Apply(v, f).(space.Point)
+		}
+		return mp
+	case space.LineString:
+		return space.LineString(applyLine(matrix.LineMatrix(g), f))
+	case space.Ring:
+		return space.Ring(applyLine(matrix.LineMatrix(g), f))
+	case space.MultiLineString:
+		ml := make(space.MultiLineString, len(g))
+		for i, v := range g {
+			ml[i] = Apply(v, f).(space.LineString)
+		}
+		return ml
+	case space.Polygon:
+		return space.Polygon(applyPolygon(matrix.PolygonMatrix(g), f))
+	case space.MultiPolygon:
+		mp := make(space.MultiPolygon, len(g))
+		for i, v := range g {
+			mp[i] = Apply(v, f).(space.Polygon)
+		}
+		return mp
+	case space.Collection:
+		coll := make(space.Collection, len(g))
+		for i, v := range g {
+			coll[i] = Apply(v, f)
+		}
+		return coll
+	case space.Bound:
+		// only the corners are transformed, the result stays axis-aligned.
+		return space.Bound{Min: Apply(g.Min, f).(space.Point), Max: Apply(g.Max, f).(space.Point)}
+	default:
+		return nil
+	}
+}
+
+func applyMatrix(m matrix.Matrix, f Func) matrix.Matrix {
+	result := make(matrix.Matrix, len(m))
+	copy(result, m)
+	result[0], result[1] = f(m[0], m[1])
+	return result
+}
+
+func applyLine(line matrix.LineMatrix, f Func) matrix.LineMatrix {
+	if line == nil {
+		return nil
+	}
+	result := make(matrix.LineMatrix, len(line))
+	for i, v := range line {
+		result[i] = applyMatrix(v, f)
+	}
+	return result
+}
+
+func applyPolygon(poly matrix.PolygonMatrix, f Func) matrix.PolygonMatrix {
+	if poly == nil {
+		return nil
+	}
+	result := make(matrix.PolygonMatrix, len(poly))
+	for i, v := range poly {
+		result[i] = applyLine(v, f)
+	}
+	return result
+}
@@ -0,0 +1,98 @@
+package projection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/encoding/wkt"
+	"github.com/spatial-go/geoos/space"
+)
+
+func TestForward(t *testing.T) {
+	tests := []struct {
+		name             string
+		f                Func
+		lon, lat         float64
+		wantLon, wantLat float64
+	}{
+		{name: "wgs84 to gcj02", f: WGS84ToGCJ02, lon: 116.404, lat: 39.915,
+			wantLon: 116.41024449916938, wantLat: 39.91640428150164},
+		{name: "gcj02 to bd09", f: GCJ02ToBD09, lon: 116.404, lat: 39.915,
+			wantLon: 116.41036949371029, wantLat: 39.92133699351021},
+		{name: "out of china", f: WGS84ToGCJ02, lon: 2.35, lat: 48.85,
+			wantLon: 2.35, wantLat: 48.85},
+		{name: "wgs84 to mercator", f: LonLatToMercator, lon: 180, lat: 0,
+			wantLon: 20037508.34278924, wantLat: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lon, lat := tt.f(tt.lon, tt.lat)
+			if math.Abs(lon-tt.wantLon) > 1e-9 || math.Abs(lat-tt.wantLat) > 1e-9 {
+				t.Errorf("got %v %v, want %v %v", lon, lat, tt.wantLon, tt.wantLat)
+			}
+		})
+	}
+}
+
+func TestTransformer_RoundTrip(t *testing.T) {
+	systems := []int{space.WGS84, space.CGCS2000, space.PseudoMercator,
+		space.GCJ02, space.GCJ02Web, space.BD09, space.BD09Web}
+	lon, lat := 121.4737, 31.2304
+	for _, from := range systems {
+		for _, to := range systems {
+			toFrom, _ := Transformer(space.WGS84, from)
+			forward, err := Transformer(from, to)
+			if err != nil {
+				t.Fatalf("Transformer(%v, %v) error = %v", from, to, err)
+			}
+			backward, _ := Transformer(to, from)
+			x, y := toFrom(lon, lat)
+			bx, by := backward(forward(x, y))
+
+			tolerance := 1e-9
+			if coordinateSystems[from].isMercator {
+				tolerance = 1e-4
+			}
+			if math.Abs(bx-x) > tolerance || math.Abs(by-y) > tolerance {
+				t.Errorf("round trip %v -> %v got %v %v, want %v %v", from, to, bx, by, x, y)
+			}
+		}
+	}
+}
+
+func TestTransform(t *testing.T) {
+	polygon, _ := wkt.UnmarshalString(`POLYGON((116 39,117 39,117 40,116 40,116 39),(116.2 39.2,116.4 39.2,116.4 39.4,116.2 39.2))`)
+	coll := space.Collection{space.Point{116.404, 39.915, 50}, polygon}
+
+	got, err := Transform(coll, space.WGS84, space.GCJ02)
+	if err != nil {
+		t.Fatalf("Transform() error = %v", err)
+	}
+	point := got.(space.Collection)[0].(space.Point)
+	if len(point) != 3 || point[2] != 50 {
+		t.Errorf("Transform() lost Z ordinate, got %v", point)
+	}
+	hole := got.(space.Collection)[1].(space.Polygon)[1]
+	wantLon, wantLat := WGS84ToGCJ02(116.2, 39.2)
+	if hole[0][0] != wantLon || hole[0][1] != wantLat {
+		t.Errorf("Transform() hole got %v, want %v %v", hole[0], wantLon, wantLat)
+	}
+	if polygon.(space.Polygon)[1][0][0] != 116.2 {
+		t.Errorf("Transform() changed the input geometry")
+	}
+
+	if _, err := Transform(coll, space.BJ54, space.WGS84); err == nil {
+		t.Errorf("Transform() from BJ54 expected error")
+	}
+}
+
+func TestTransform_SRID(t *testing.T) {
+	geom := space.WithSRID(space.Point{116.404, 39.915}, space.WGS84)
+	got, err := Transform(geom, space.WGS84, space.GCJ02)
+	if err != nil || space.SRIDOf(got) != space.GCJ02 {
+		t.Errorf("Transform() = %v, %v, want SRID %v", got, err, space.GCJ02)
+	}
+	if _, err := Transform(geom, space.GCJ02, space.WGS84); err == nil {
+		t.Errorf("Transform() from a different SRID expected error")
+	}
+}
@@ -103,11 +103,12 @@ func (c Collection) Equals(g Geometry) bool {
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (c Collection) EqualsExact(g Geometry, tolerance float64) bool {
-	if c.GeoJSONType() != g.GeoJSONType() {
+	other, ok := Unwrap(g).(Collection)
+	if !ok || len(c) != len(other) {
 		return false
 	}
 	for i, v := range c {
-		if v.EqualsExact((g.(Collection)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -236,6 +237,8 @@ func (c Collection) Buffer(width float64, quadsegs int) Geometry {
 		return LineString(b)
 	case matrix.PolygonMatrix:
 		return Polygon(b)
+	case matrix.Collection:
+		return TransGeometry(b)
 	}
 	return nil
 }
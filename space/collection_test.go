@@ -0,0 +1,38 @@
+package space
+
+import "testing"
+
+func TestCollection_EqualsExact(t *testing.T) {
+	points := MultiPoint{{0, 0}, {1, 1}}
+	lines := MultiLineString{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}
+	polys := MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}
+	coll := Collection{Point{0, 0}, LineString{{0, 0}, {1, 1}}}
+	tests := []struct {
+		name string
+		g1   Geometry
+		g2   Geometry
+		want bool
+	}{
+		{name: "collection", g1: coll, g2: Collection{Point{0, 0}, LineString{{0, 0}, {1, 1}}}, want: true},
+		{name: "collection differs", g1: coll, g2: Collection{Point{0, 0}, LineString{{0, 0}, {1, 2}}}, want: false},
+		{name: "collection tolerance", g1: coll, g2: Collection{Point{0, 0.1}, LineString{{0, 0}, {1, 1}}}, want: true},
+		{name: "collection prefix", g1: coll, g2: Collection{Point{0, 0}}, want: false},
+		{name: "collection with srid", g1: coll, g2: WithSRID(coll, WGS84), want: true},
+		{name: "multipoint", g1: points, g2: MultiPoint{{0, 0}, {1, 1}}, want: true},
+		{name: "multipoint differs", g1: points, g2: MultiPoint{{0, 0}, {1, 2}}, want: false},
+		{name: "multipoint longer", g1: points, g2: MultiPoint{{0, 0}, {1, 1}, {2, 2}}, want: false},
+		{name: "multipoint with srid", g1: points, g2: WithSRID(points, WGS84), want: true},
+		{name: "multilinestring", g1: lines, g2: MultiLineString{{{0, 0}, {1, 1}}, {{2, 2}, {3, 3}}}, want: true},
+		{name: "multilinestring prefix", g1: lines, g2: MultiLineString{{{0, 0}, {1, 1}}}, want: false},
+		{name: "multipolygon", g1: polys, g2: MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}}, want: true},
+		{name: "multipolygon differs", g1: polys, g2: MultiPolygon{{{{0, 0}, {2, 0}, {1, 1}, {0, 0}}}}, want: false},
+		{name: "other type", g1: points, g2: Point{0, 0}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.g1.EqualsExact(tt.g2, 0.2); got != tt.want {
+				t.Errorf("EqualsExact() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
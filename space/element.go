@@ -45,12 +45,21 @@ type ElementValid struct {
 }
 
 // CreateElementValid Returns valid geom element. returns nil if geom is invalid.
+// The coordinate system is the SRID of geom, GCJ02 if geom has none.
 func CreateElementValid(geom Geometry) (*ElementValid, error) {
+	if srid := SRIDOf(geom); srid != 0 {
+		return CreateElementValidWithCoordSys(geom, srid)
+	}
 	return CreateElementValidWithCoordSys(geom, GCJ02)
 }
 
 // CreateElementValidWithCoordSys Returns valid geom element. returns nil if geom is invalid.
+// The coordinate system is only recorded in the element, geom is not tagged with it as its SRID,
+// an error is returned if geom already has a different SRID.
 func CreateElementValidWithCoordSys(geom Geometry, coordSys int) (*ElementValid, error) {
+	if srid := SRIDOf(geom); srid != 0 && srid != coordSys {
+		return nil, &spaceerr.SRIDMismatchError{SRID1: srid, SRID2: coordSys}
+	}
 	if geom.IsValid() {
 		return &ElementValid{geom, coordSys}, nil
 	}
@@ -79,6 +88,22 @@ func Distance(from, to Geometry, f measure.Distance) (float64, error) {
 	return elem.Distance()
 }
 
+// NearestPoints returns the points of the two Geometry which are nearest to each other by the distance func,
+// the first on from and the second on to, nil points if a Geometry is empty.
+func NearestPoints(from, to Geometry, f measure.Distance) (Point, Point, error) {
+	if from == nil || to == nil {
+		return nil, nil, spaceerr.ErrNilGeometry
+	}
+	if from.IsEmpty() || to.IsEmpty() {
+		return nil, nil, nil
+	}
+	ptDist := measure.NearestPoints(from.ToMatrix(), to.ToMatrix(), f)
+	if ptDist == nil {
+		return nil, nil, nil
+	}
+	return Point(ptDist.Pt[0]), Point(ptDist.Pt[1]), nil
+}
+
 // Relate Computes the  Intersection Matrix for the spatial relationship
 // between two geometries, using the default (OGC SFS) Boundary Node Rule
 func Relate(a, b Geometry) (string, error) {
@@ -226,7 +251,7 @@ func aInB(A, B Geometry) (bool, bool) {
 		return false, true
 	}
 	// optimization for rectangle arguments
-	if B.GeoJSONType() == TypePolygon && B.(Polygon).IsRectangle() {
+	if poly, ok := Unwrap(B).(Polygon); ok && poly.IsRectangle() {
 		return B.Bound().ContainsBound(A.Bound()), true
 	}
 
@@ -120,4 +120,6 @@ var (
 	_ Geometry = Bound{}
 
 	_ Geometry = Collection{}
+
+	_ Geometry = SRIDGeometry{}
 )
@@ -95,11 +95,12 @@ func (mls MultiLineString) Equals(g Geometry) bool {
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (mls MultiLineString) EqualsExact(g Geometry, tolerance float64) bool {
-	if mls.GeoJSONType() != g.GeoJSONType() {
+	other, ok := Unwrap(g).(MultiLineString)
+	if !ok || len(mls) != len(other) {
 		return false
 	}
 	for i, v := range mls {
-		if !v.EqualsExact((g.(MultiLineString)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -214,6 +215,8 @@ func (mls MultiLineString) Buffer(width float64, quadsegs int) Geometry {
 		return LineString(b)
 	case matrix.PolygonMatrix:
 		return Polygon(b)
+	case matrix.Collection:
+		return TransGeometry(b)
 	}
 	return nil
 }
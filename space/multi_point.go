@@ -81,11 +81,12 @@ func (mp MultiPoint) Equals(g Geometry) bool {
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (mp MultiPoint) EqualsExact(g Geometry, tolerance float64) bool {
-	if mp.GeoJSONType() != g.GeoJSONType() {
+	other, ok := Unwrap(g).(MultiPoint)
+	if !ok || len(mp) != len(other) {
 		return false
 	}
 	for i, v := range mp {
-		if v.EqualsExact((g.(MultiPoint)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -171,6 +172,8 @@ func (mp MultiPoint) Buffer(width float64, quadsegs int) Geometry {
 		return LineString(b)
 	case matrix.PolygonMatrix:
 		return Polygon(b)
+	case matrix.Collection:
+		return TransGeometry(b)
 	}
 	return nil
 }
@@ -80,11 +80,12 @@ func (mp MultiPolygon) Equals(g Geometry) bool {
 // up to a specified distance tolerance.
 // Two Geometries are exactly equal within a distance tolerance
 func (mp MultiPolygon) EqualsExact(g Geometry, tolerance float64) bool {
-	if mp.GeoJSONType() != g.GeoJSONType() {
+	other, ok := Unwrap(g).(MultiPolygon)
+	if !ok || len(mp) != len(other) {
 		return false
 	}
 	for i, v := range mp {
-		if v.EqualsExact((g.(MultiPolygon)[i]), tolerance) {
+		if !v.EqualsExact(other[i], tolerance) {
 			return false
 		}
 	}
@@ -190,6 +191,8 @@ func (mp MultiPolygon) Buffer(width float64, quadsegs int) Geometry {
 		return LineString(b)
 	case matrix.PolygonMatrix:
 		return Polygon(b)
+	case matrix.Collection:
+		return TransGeometry(b)
 	}
 	return nil
 }
@@ -0,0 +1,215 @@
+package space
+
+import (
+	"math"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+)
+
+// HasZ returns true if the coordinates of the geometry have a z ordinate.
+// The first coordinate decides for the whole geometry.
+func HasZ(geom Geometry) bool {
+	if first := firstCoordinate(geom); first != nil {
+		return first.HasZ()
+	}
+	return false
+}
+
+// HasM returns true if the coordinates of the geometry have a m ordinate.
+// The first coordinate decides for the whole geometry.
+func HasM(geom Geometry) bool {
+	if first := firstCoordinate(geom); first != nil {
+		return first.HasM()
+	}
+	return false
+}
+
+// Force2D returns a copy of the geometry with only the x and y ordinates.
+func Force2D(geom Geometry) Geometry {
+	return transCoordinates(geom, func(m matrix.Matrix) matrix.Matrix {
+		return matrix.Matrix{m[0], m[1]}
+	})
+}
+
+// Force3D returns a copy of the geometry with the x, y and z ordinates, a missing z is set to 0.
+// The m ordinate is dropped.
+func Force3D(geom Geometry) Geometry {
+	return transCoordinates(geom, func(m matrix.Matrix) matrix.Matrix {
+		if m.HasZ() {
+			return matrix.Matrix{m[0], m[1], m[2]}
+		}
+		return matrix.Matrix{m[0], m[1], 0}
+	})
+}
+
+// Force4D returns a copy of the geometry with the x, y, z and m ordinates, a missing z or m is set to 0.
+func Force4D(geom Geometry) Geometry {
+	return transCoordinates(geom, func(m matrix.Matrix) matrix.Matrix {
+		return matrix.Matrix{m[0], m[1], ordinateOrZero(m.Z()), ordinateOrZero(m.M())}
+	})
+}
+
+// Length3D returns the 3D length of the geometry, a missing z is taken as 0.
+// The length of a polygon is the length of its rings.
+func Length3D(geom Geometry) float64 {
+	return length3D(Unwrap(geom).ToMatrix())
+}
+
+// Distance3D returns the minimum 3D distance Between the two Geometry, a missing z is taken as 0.
+// Polygons are measured by their rings.
+func Distance3D(from, to Geometry) (float64, error) {
+	if _, err := CheckSRID(from, to); err != nil {
+		return 0, err
+	}
+	if from == nil || from.IsEmpty() ||
+		to == nil || to.IsEmpty() {
+		return 0, nil
+	}
+	return measure.Distance3D(Unwrap(from).ToMatrix(), Unwrap(to).ToMatrix()), nil
+}
+
+// Z returns the z ordinate of the point, NaN if it has none.
+func (p Point) Z() float64 {
+	return matrix.Matrix(p).Z()
+}
+
+// M returns the m ordinate of the point, NaN if it has none.
+func (p Point) M() float64 {
+	return matrix.Matrix(p).M()
+}
+
+// HasZ returns true if the point has a z ordinate.
+func (p Point) HasZ() bool {
+	return matrix.Matrix(p).HasZ()
+}
+
+// HasM returns true if the point has a m ordinate.
+func (p Point) HasM() bool {
+	return matrix.Matrix(p).HasM()
+}
+
+// CreatePointZM returns a point of the ordinates, z or m may be NaN if the point has none.
+func CreatePointZM(x, y, z, m float64) Point {
+	switch {
+	case math.IsNaN(m) && math.IsNaN(z):
+		return Point{x, y}
+	case math.IsNaN(m):
+		return Point{x, y, z}
+	default:
+		return Point{x, y, z, m}
+	}
+}
+
+func length3D(steric matrix.Steric) float64 {
+	switch m := steric.(type) {
+	case matrix.LineMatrix:
+		return measure.OfLine3D(m)
+	case matrix.PolygonMatrix:
+		length := 0.0
+		for _, v := range m {
+			length += measure.OfLine3D(v)
+		}
+		return length
+	case matrix.Collection:
+		length := 0.0
+		for _, v := range m {
+			length += length3D(v)
+		}
+		return length
+	default:
+		return 0.0
+	}
+}
+
+func firstCoordinate(geom Geometry) matrix.Matrix {
+	switch g := geom.(type) {
+	case nil:
+		return nil
+	case SRIDGeometry:
+		return firstCoordinate(g.Geometry)
+	case Bound:
+		return matrix.Matrix(g.Min)
+	}
+	for _, v := range matrix.TransMatrixes(geom.ToMatrix()) {
+		if !v.IsEmpty() {
+			return v
+		}
+	}
+	return nil
+}
+
+// transCoordinates returns a copy of the geometry with f applied to every coordinate.
+func transCoordinates(geom Geometry, f func(matrix.Matrix) matrix.Matrix) Geometry {
+	transLine := func(line matrix.LineMatrix) matrix.LineMatrix {
+		if line == nil {
+			return nil
+		}
+		result := make(matrix.LineMatrix, len(line))
+		for i, v := range line {
+			result[i] = f(v)
+		}
+		return result
+	}
+	transPolygon := func(poly matrix.PolygonMatrix) matrix.PolygonMatrix {
+		if poly == nil {
+			return nil
+		}
+		result := make(matrix.PolygonMatrix, len(poly))
+		for i, v := range poly {
+			result[i] = transLine(v)
+		}
+		return result
+	}
+
+	switch g := geom.(type) {
+	case SRIDGeometry:
+		return WithSRID(transCoordinates(g.Geometry, f), g.SRID)
+	case Point:
+		if g.IsEmpty() {
+			return g
+		}
+		return Point(f(matrix.Matrix(g)))
+	case MultiPoint:
+		mp := make(MultiPoint, len(g))
+		for i, v := range g {
+			mp[i] = transCoordinates(v, f).(Point)
+		}
+		return mp
+	case LineString:
+		return LineString(transLine(matrix.LineMatrix(g)))
+	case Ring:
+		return Ring(transLine(matrix.LineMatrix(g)))
+	case MultiLineString:
+		ml := make(MultiLineString, len(g))
+		for i, v := range g {
+			ml[i] = LineString(transLine(matrix.LineMatrix(v)))
+		}
+		return ml
+	case Polygon:
+		return Polygon(transPolygon(matrix.PolygonMatrix(g)))
+	case MultiPolygon:
+		mp := make(MultiPolygon, len(g))
+		for i, v := range g {
+			mp[i] = Polygon(transPolygon(matrix.PolygonMatrix(v)))
+		}
+		return mp
+	case Collection:
+		coll := make(Collection, len(g))
+		for i, v := range g {
+			coll[i] = transCoordinates(v, f)
+		}
+		return coll
+	case Bound:
+		return Bound{Min: transCoordinates(g.Min, f).(Point), Max: transCoordinates(g.Max, f).(Point)}
+	default:
+		return geom
+	}
+}
+
+func ordinateOrZero(v float64) float64 {
+	if math.IsNaN(v) {
+		return 0
+	}
+	return v
+}
@@ -0,0 +1,78 @@
+package space
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHasZM(t *testing.T) {
+	tests := []struct {
+		name       string
+		geom       Geometry
+		hasZ, hasM bool
+	}{
+		{name: "xy", geom: Point{1, 2}},
+		{name: "xyz", geom: LineString{{1, 2, 3}, {4, 5, 6}}, hasZ: true},
+		{name: "xym", geom: CreatePointZM(1, 2, math.NaN(), 4), hasM: true},
+		{name: "xyzm", geom: Polygon{{{0, 0, 1, 2}, {1, 0, 1, 2}, {1, 1, 1, 2}, {0, 0, 1, 2}}}, hasZ: true, hasM: true},
+		{name: "srid", geom: WithSRID(Point{1, 2, 3}, WGS84), hasZ: true},
+		{name: "empty", geom: Collection{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasZ(tt.geom); got != tt.hasZ {
+				t.Errorf("HasZ() = %v, want %v", got, tt.hasZ)
+			}
+			if got := HasM(tt.geom); got != tt.hasM {
+				t.Errorf("HasM() = %v, want %v", got, tt.hasM)
+			}
+		})
+	}
+}
+
+func TestForce(t *testing.T) {
+	line := LineString{{1, 2, 3, 4}, {5, 6, 7, 8}}
+	if got := Force2D(line); !got.EqualsExact(LineString{{1, 2}, {5, 6}}, 0) || HasZ(got) {
+		t.Errorf("Force2D() = %v", got)
+	}
+	if got := Force3D(Point{1, 2}); len(got.(Point)) != 3 || got.(Point).Z() != 0 {
+		t.Errorf("Force3D() = %v", got)
+	}
+	if got := Force3D(line).(LineString); Point(got[1]).Z() != 7 || HasM(got) {
+		t.Errorf("Force3D() = %v", got)
+	}
+	if got := Force4D(CreatePointZM(1, 2, math.NaN(), 4)).(Point); got.Z() != 0 || got.M() != 4 {
+		t.Errorf("Force4D() = %v", got)
+	}
+	if line[0][2] != 3 {
+		t.Errorf("Force2D() changed the geometry %v", line)
+	}
+}
+
+func TestDistance3D(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to Geometry
+		want     float64
+	}{
+		{name: "point point", from: Point{0, 0, 0}, to: Point{1, 2, 2}, want: 3},
+		{name: "missing z", from: Point{0, 0}, to: Point{0, 0, 5}, want: 5},
+		{name: "point line", from: Point{1, 0, 5}, to: LineString{{0, 0, 0}, {2, 0, 0}}, want: 5},
+		{name: "skew lines", from: LineString{{0, 0, 0}, {2, 0, 0}}, to: LineString{{1, -1, 1}, {1, 1, 1}}, want: 1},
+		{name: "polygon ring", from: Point{0.5, 0.5, 0}, to: Polygon{{{0, 0, 0}, {1, 0, 0}, {1, 1, 0}, {0, 1, 0}, {0, 0, 0}}}, want: 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Distance3D(tt.from, tt.to)
+			if err != nil || math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Distance3D() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+	if _, err := Distance3D(WithSRID(Point{0, 0}, WGS84), WithSRID(Point{0, 0}, PseudoMercator)); err == nil {
+		t.Errorf("Distance3D() want SRID mismatch error")
+	}
+	if got := Length3D(LineString{{0, 0, 0}, {1, 2, 2}, {1, 2, 3}}); got != 4 {
+		t.Errorf("Length3D() = %v, want 4", got)
+	}
+}
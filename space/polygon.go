@@ -244,6 +244,8 @@ func (p Polygon) Buffer(width float64, quadsegs int) Geometry {
 		return LineString(b)
 	case matrix.PolygonMatrix:
 		return Polygon(b)
+	case matrix.Collection:
+		return TransGeometry(b)
 	}
 	return nil
 }
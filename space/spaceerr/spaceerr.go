@@ -37,3 +37,18 @@ func ErrorUsageFunc(obj ...interface{}) error {
 func Error(str string, obj ...interface{}) error {
 	return fmt.Errorf(str, obj...)
 }
+
+// ErrorNotSupportCoordinateSystem create new ErrorNotSupportCoordinateSystem by coordinate system.
+func ErrorNotSupportCoordinateSystem(obj ...interface{}) error {
+	return fmt.Errorf("Coordinate system is not supported: %v", obj...)
+}
+
+// SRIDMismatchError is returned by operations on two geometries whose SRIDs differ.
+type SRIDMismatchError struct {
+	SRID1, SRID2 int
+}
+
+// Error returns the message of the SRIDMismatchError.
+func (e *SRIDMismatchError) Error() string {
+	return fmt.Sprintf("Operation on mixed SRID geometries: %d != %d", e.SRID1, e.SRID2)
+}
@@ -0,0 +1,195 @@
+package space
+
+import (
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+// SRIDGeometry is a geometry tagged with the SRID (spatial reference identifier) of its coordinate system,
+// e.g. WGS84, PseudoMercator or GCJ02.
+// Distance, Length and Area of a geometry with a geographic SRID are computed on the sphere in m,
+// otherwise in the planar units of the coordinate system.
+type SRIDGeometry struct {
+	Geometry
+	SRID int
+}
+
+// WithSRID returns geom tagged with srid. An SRID of 0 means unknown and returns the untagged geometry.
+func WithSRID(geom Geometry, srid int) Geometry {
+	geom = Unwrap(geom)
+	if geom == nil || srid == 0 {
+		return geom
+	}
+	return SRIDGeometry{Geometry: geom, SRID: srid}
+}
+
+// SRIDOf returns the SRID of geom, 0 if geom has none.
+func SRIDOf(geom Geometry) int {
+	if g, ok := geom.(SRIDGeometry); ok {
+		return g.SRID
+	}
+	return 0
+}
+
+// Unwrap returns geom without its SRID.
+func Unwrap(geom Geometry) Geometry {
+	if g, ok := geom.(SRIDGeometry); ok {
+		return Unwrap(g.Geometry)
+	}
+	return geom
+}
+
+// CheckSRID returns the common SRID of geoms, geometries without SRID are ignored.
+// It returns a *spaceerr.SRIDMismatchError if two of the SRIDs differ.
+func CheckSRID(geoms ...Geometry) (int, error) {
+	srid := 0
+	for _, v := range geoms {
+		s := SRIDOf(v)
+		if s == 0 {
+			continue
+		}
+		if srid != 0 && srid != s {
+			return 0, &spaceerr.SRIDMismatchError{SRID1: srid, SRID2: s}
+		}
+		srid = s
+	}
+	return srid, nil
+}
+
+// IsGeographic returns true if the coordinate system of srid is lon/lat in degree.
+func IsGeographic(srid int) bool {
+	switch srid {
+	case WGS84, CGCS2000, GCJ02, BD09:
+		return true
+	default:
+		return false
+	}
+}
+
+// Area returns the area of a polygonal geometry, in square meter for a geographic SRID.
+func (s SRIDGeometry) Area() (float64, error) {
+	if !IsGeographic(s.SRID) {
+		return s.Geometry.Area()
+	}
+	return spheroidArea(s.Geometry.ToMatrix()), nil
+}
+
+// Length Returns the length of this geometry, in meter for a geographic SRID.
+func (s SRIDGeometry) Length() float64 {
+	if !IsGeographic(s.SRID) {
+		return s.Geometry.Length()
+	}
+	return spheroidLength(s.Geometry.ToMatrix())
+}
+
+// Distance returns distance Between the two Geometry, in meter for a geographic SRID.
+func (s SRIDGeometry) Distance(g Geometry) (float64, error) {
+	if _, err := CheckSRID(s, g); err != nil {
+		return 0, err
+	}
+	if IsGeographic(s.SRID) {
+		return Distance(s.Geometry, Unwrap(g), measure.SpheroidDistance)
+	}
+	return s.Geometry.Distance(Unwrap(g))
+}
+
+// SpheroidDistance returns  spheroid distance Between the two Geometry.
+func (s SRIDGeometry) SpheroidDistance(g Geometry) (float64, error) {
+	if _, err := CheckSRID(s, g); err != nil {
+		return 0, err
+	}
+	return s.Geometry.SpheroidDistance(Unwrap(g))
+}
+
+// Equals returns true if the Geometry represents the same Geometry in the same coordinate system.
+func (s SRIDGeometry) Equals(g Geometry) bool {
+	if _, err := CheckSRID(s, g); err != nil {
+		return false
+	}
+	return s.Geometry.Equals(Unwrap(g))
+}
+
+// EqualsExact Returns true if the two Geometries are exactly equal in the same coordinate system,
+// up to a specified distance tolerance.
+func (s SRIDGeometry) EqualsExact(g Geometry, tolerance float64) bool {
+	if _, err := CheckSRID(s, g); err != nil {
+		return false
+	}
+	return s.Geometry.EqualsExact(Unwrap(g), tolerance)
+}
+
+// Boundary returns the closure of the combinatorial boundary of this Geometry.
+func (s SRIDGeometry) Boundary() (Geometry, error) {
+	boundary, err := s.Geometry.Boundary()
+	return WithSRID(boundary, s.SRID), err
+}
+
+// Buffer Returns a geometry that represents all points whose distance
+// from this Geometry is less than or equal to distance.
+func (s SRIDGeometry) Buffer(width float64, quadsegs int) Geometry {
+	return WithSRID(s.Geometry.Buffer(width, quadsegs), s.SRID)
+}
+
+// ConvexHull computes the convex hull of a geometry.
+func (s SRIDGeometry) ConvexHull() Geometry {
+	return WithSRID(s.Geometry.ConvexHull(), s.SRID)
+}
+
+// Envelope returns the  minimum bounding box for the supplied geometry, as a geometry.
+func (s SRIDGeometry) Envelope() Geometry {
+	return WithSRID(s.Geometry.Envelope(), s.SRID)
+}
+
+// PointOnSurface Returns a POINT guaranteed to intersect a surface.
+func (s SRIDGeometry) PointOnSurface() Geometry {
+	return WithSRID(s.Geometry.PointOnSurface(), s.SRID)
+}
+
+// Simplify returns a "simplified" version of the given geometry using the Douglas-Peucker algorithm,
+// May not preserve topology
+func (s SRIDGeometry) Simplify(tolerance float64) Geometry {
+	return WithSRID(s.Geometry.Simplify(tolerance), s.SRID)
+}
+
+// SimplifyP returns a geometry simplified by amount given by tolerance.
+// Unlike Simplify, SimplifyP guarantees it will preserve topology.
+func (s SRIDGeometry) SimplifyP(tolerance float64) Geometry {
+	return WithSRID(s.Geometry.SimplifyP(tolerance), s.SRID)
+}
+
+func spheroidArea(steric matrix.Steric) float64 {
+	switch m := steric.(type) {
+	case matrix.PolygonMatrix:
+		return measure.SpheroidAreaOfPolygon(m)
+	case matrix.Collection:
+		area := 0.0
+		for _, v := range m {
+			area += spheroidArea(v)
+		}
+		return area
+	default:
+		return 0.0
+	}
+}
+
+func spheroidLength(steric matrix.Steric) float64 {
+	switch m := steric.(type) {
+	case matrix.LineMatrix:
+		return measure.SpheroidOfLine(m)
+	case matrix.PolygonMatrix:
+		length := 0.0
+		for _, v := range m {
+			length += measure.SpheroidOfLine(v)
+		}
+		return length
+	case matrix.Collection:
+		length := 0.0
+		for _, v := range m {
+			length += spheroidLength(v)
+		}
+		return length
+	default:
+		return 0.0
+	}
+}
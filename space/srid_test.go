@@ -0,0 +1,72 @@
+package space
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/spatial-go/geoos/algorithm/matrix"
+	"github.com/spatial-go/geoos/algorithm/measure"
+	"github.com/spatial-go/geoos/space/spaceerr"
+)
+
+func TestSRIDGeometry_Measure(t *testing.T) {
+	square := Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}
+	degree := measure.R * math.Pi / 180
+	tests := []struct {
+		name       string
+		geom       Geometry
+		wantArea   float64
+		wantLength float64
+	}{
+		{name: "planar", geom: WithSRID(square, PseudoMercator), wantArea: 1, wantLength: 4},
+		{name: "geodesic", geom: WithSRID(square, WGS84),
+			wantArea:   measure.R * measure.R * math.Pi / 180 * math.Sin(math.Pi/180),
+			wantLength: 3*degree + degree*math.Cos(math.Pi/180)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := tt.geom.Area(); math.Abs(got-tt.wantArea)/tt.wantArea > 1e-3 {
+				t.Errorf("SRIDGeometry.Area() = %v, want %v", got, tt.wantArea)
+			}
+			if got := tt.geom.Length(); math.Abs(got-tt.wantLength)/tt.wantLength > 1e-3 {
+				t.Errorf("SRIDGeometry.Length() = %v, want %v", got, tt.wantLength)
+			}
+		})
+	}
+}
+
+func TestSRIDGeometry_Distance(t *testing.T) {
+	from, to := Point{116.404, 39.915}, Point{121.4737, 31.2304}
+	got, err := WithSRID(from, WGS84).Distance(WithSRID(to, WGS84))
+	if err != nil {
+		t.Fatalf("SRIDGeometry.Distance() error = %v", err)
+	}
+	if want := measure.SpheroidDistance(matrix.Matrix(from), matrix.Matrix(to)); got != want {
+		t.Errorf("SRIDGeometry.Distance() = %v, want %v", got, want)
+	}
+	if got, _ := WithSRID(from, PseudoMercator).Distance(to); got != measure.PlanarDistance(matrix.Matrix(from), matrix.Matrix(to)) {
+		t.Errorf("SRIDGeometry.Distance() planar = %v", got)
+	}
+
+	_, err = WithSRID(from, WGS84).Distance(WithSRID(to, GCJ02))
+	var mismatch *spaceerr.SRIDMismatchError
+	if !errors.As(err, &mismatch) || mismatch.SRID1 != WGS84 || mismatch.SRID2 != GCJ02 {
+		t.Errorf("SRIDGeometry.Distance() error = %v, want SRIDMismatchError", err)
+	}
+}
+
+func TestCreateElementValid_SRID(t *testing.T) {
+	elem, err := CreateElementValid(WithSRID(Point{1, 1}, WGS84))
+	if err != nil || elem.CoordinateSystem != WGS84 || SRIDOf(elem.Geometry) != WGS84 {
+		t.Errorf("CreateElementValid() = %v, %v", elem, err)
+	}
+	// an untagged geometry keeps its planar measures.
+	elem, err = CreateElementValid(LineString{{0, 0}, {3, 4}})
+	if err != nil || elem.CoordinateSystem != GCJ02 || SRIDOf(elem.Geometry) != 0 || elem.Length() != 5 {
+		t.Errorf("CreateElementValid() = %v, %v, want an untagged geometry of length 5", elem, err)
+	}
+	if _, err := CreateElementValidWithCoordSys(WithSRID(Point{1, 1}, WGS84), GCJ02); err == nil {
+		t.Errorf("CreateElementValidWithCoordSys() expected SRID mismatch error")
+	}
+}